@@ -0,0 +1,236 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// Credentials are the login and password of a BIG-IP user.
+type Credentials struct {
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	LoginProvider string `json:"login_provider,omitempty"` // The login provider used for token authentication, tmos by default.
+}
+
+// CredentialProvider supplies credentials each time the client needs to authenticate,
+// so that secrets don't have to be held by the client.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsFunc adapts a callback to the CredentialProvider interface.
+type CredentialsFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialsFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider always supplying the given login and password.
+func StaticCredentials(username, password string) CredentialProvider {
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		return Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// EnvCredentials returns a provider reading the login and password from environment variables.
+func EnvCredentials(usernameVar, passwordVar string) CredentialProvider {
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		username, ok := os.LookupEnv(usernameVar)
+		if !ok {
+			return Credentials{}, fmt.Errorf("soap: environment variable %s is not set", usernameVar)
+		}
+		password, ok := os.LookupEnv(passwordVar)
+		if !ok {
+			return Credentials{}, fmt.Errorf("soap: environment variable %s is not set", passwordVar)
+		}
+		return Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// FileCredentials returns a provider reading a JSON encoded Credentials from path.
+// The file is read each time credentials are needed, so it may be rotated.
+func FileCredentials(path string) CredentialProvider {
+	return CredentialsFunc(func(context.Context) (Credentials, error) {
+		bt, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Credentials{}, err
+		}
+		var creds Credentials
+		if err := json.Unmarshal(bt, &creds); err != nil {
+			return Credentials{}, fmt.Errorf("soap: invalid credentials file %s: %w", path, err)
+		}
+		return creds, nil
+	})
+}
+
+// WithCredentials authenticates every request with HTTP basic auth,
+// asking p for the credentials each time.
+func WithCredentials(p CredentialProvider) Option {
+	return func(o *options) {
+		o.credentials = p
+		o.tokenAuth = false
+	}
+}
+
+// WithTokenAuth logs in once with the credentials of p, then authenticates every request
+// with the X-F5-Auth-Token header. The token is refreshed before it expires
+// and the client logs in again when the device answers 401.
+func WithTokenAuth(p CredentialProvider) Option {
+	return func(o *options) {
+		o.credentials = p
+		o.tokenAuth = true
+	}
+}
+
+// WithTokenRefreshMargin sets how long before its expiry a token is renewed.
+func WithTokenRefreshMargin(d time.Duration) Option {
+	return func(o *options) {
+		o.tokenMargin = d
+	}
+}
+
+const (
+	tokenHeader       = "X-F5-Auth-Token"
+	loginPath         = "/mgmt/shared/authn/login"
+	defaultLoginScope = "tmos"
+)
+
+// tokenSource obtains, caches and renews the authentication token of a client.
+type tokenSource struct {
+	mu       sync.Mutex
+	provider CredentialProvider
+	client   HTTPClient
+	loginURL string
+	margin   time.Duration
+
+	token  string
+	expiry time.Time
+}
+
+func newTokenSource(portalURL string, opts *options, client HTTPClient) *tokenSource {
+
+	loginURL := loginPath
+	if u, err := url.Parse(portalURL); err == nil {
+		u.Path, u.RawQuery = loginPath, ""
+		loginURL = u.String()
+	}
+
+	return &tokenSource{
+		provider: opts.credentials,
+		client:   client,
+		loginURL: loginURL,
+		margin:   opts.tokenMargin,
+	}
+}
+
+// Token returns a valid token, logging in when there is none or it is about to expire.
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(s.margin).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	return s.login(ctx)
+}
+
+// invalidate drops token if it is still the cached one, forcing the next call to log in.
+func (s *tokenSource) invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+type loginReq struct {
+	Username          string `json:"username"`
+	Password          string `json:"password"`
+	LoginProviderName string `json:"loginProviderName"`
+}
+
+type loginResp struct {
+	Token struct {
+		Token            string `json:"token"`
+		Timeout          int64  `json:"timeout"`
+		ExpirationMicros int64  `json:"expirationMicros"`
+	} `json:"token"`
+}
+
+func (s *tokenSource) login(ctx context.Context) (string, error) {
+
+	creds, err := s.provider.Credentials(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	provider := creds.LoginProvider
+	if provider == "" {
+		provider = defaultLoginScope
+	}
+
+	bt, err := json.Marshal(loginReq{
+		Username:          creds.Username,
+		Password:          creds.Password,
+		LoginProviderName: provider,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", s.loginURL, bytes.NewReader(bt))
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-f5-soap/0.1")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode >= 400 {
+		return "", &HTTPError{StatusCode: res.StatusCode, ResponseBody: body}
+	}
+
+	var resp loginResp
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", err
+	}
+	if resp.Token.Token == "" {
+		return "", fmt.Errorf("soap: login response carries no token")
+	}
+
+	now := time.Now()
+	switch {
+	case resp.Token.Timeout > 0:
+		s.expiry = now.Add(time.Duration(resp.Token.Timeout) * time.Second)
+	case resp.Token.ExpirationMicros > 0:
+		s.expiry = time.Unix(0, resp.Token.ExpirationMicros*int64(time.Microsecond))
+	default:
+		s.expiry = now.Add(defaultTokenLifetime)
+	}
+	s.token = resp.Token.Token
+
+	return s.token, nil
+}
+
+// defaultTokenLifetime is the lifetime of tokens issued by BIG-IP when the login response doesn't tell.
+const defaultTokenLifetime = 20 * time.Minute
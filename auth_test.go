@@ -0,0 +1,245 @@
+package soap_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+// authServer emulates the token login endpoint and the iControl portal of a device.
+type authServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	timeout int64
+	logins  int
+	valid   map[string]bool
+	creds   []string
+	basic   []string
+}
+
+func newAuthServer(t *testing.T, timeout int64) *authServer {
+	s := &authServer{timeout: timeout, valid: map[string]bool{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mgmt/shared/authn/login", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Username          string `json:"username"`
+			Password          string `json:"password"`
+			LoginProviderName string `json:"loginProviderName"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.creds = append(s.creds, req.Username+":"+req.Password+"@"+req.LoginProviderName)
+		if req.Password != "admin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.logins++
+		token := fmt.Sprintf("token-%d", s.logins)
+		s.valid[token] = true
+		_, _ = fmt.Fprintf(w, `{"username":%q,"token":{"token":%q,"timeout":%d}}`, req.Username, token, s.timeout)
+	})
+	mux.HandleFunc("/iControl/iControlPortal.cgi", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if user, pass, ok := r.BasicAuth(); ok {
+			s.basic = append(s.basic, user+":"+pass)
+		} else if !s.valid[r.Header.Get("X-F5-Auth-Token")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(ttlResponse))
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *authServer) portal() string {
+	return s.URL + "/iControl/iControlPortal.cgi"
+}
+
+func (s *authServer) revokeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.valid = map[string]bool{}
+}
+
+func (s *authServer) loginCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+func TestTokenAuth_LoginOnce(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+	p := pool.New(soap.NewClient(s.portal(), soap.WithTokenAuth(soap.StaticCredentials("admin", "admin"))))
+
+	for i := 0; i < 3; i++ {
+		if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := s.loginCount(); n != 1 {
+		t.Fatalf("expected a single login, got %d", n)
+	}
+	if len(s.basic) != 0 {
+		t.Fatalf("basic auth must not be sent with token auth, got %v", s.basic)
+	}
+	if s.creds[0] != "admin:admin@tmos" {
+		t.Fatalf("unexpected login request %q", s.creds[0])
+	}
+}
+
+func TestTokenAuth_RefreshBeforeExpiry(t *testing.T) {
+
+	s := newAuthServer(t, 1)
+	p := pool.New(soap.NewClient(s.portal(),
+		soap.WithTokenAuth(soap.StaticCredentials("admin", "admin")),
+		soap.WithTokenRefreshMargin(2*time.Second),
+	))
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := s.loginCount(); n != 2 {
+		t.Fatalf("expected the token to be renewed, got %d logins", n)
+	}
+}
+
+func TestTokenAuth_ReauthenticateOn401(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+	p := pool.New(soap.NewClient(s.portal(), soap.WithTokenAuth(soap.StaticCredentials("admin", "admin"))))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.revokeAll()
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.loginCount(); n != 2 {
+		t.Fatalf("expected a new login after 401, got %d logins", n)
+	}
+}
+
+func TestTokenAuth_ReauthenticateOn401Limited(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+	c := soap.NewClient(s.portal(),
+		soap.WithTokenAuth(soap.StaticCredentials("admin", "admin")),
+		soap.WithLimits(soap.Limits{MaxInFlight: 1}),
+	)
+	p := pool.New(c)
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.revokeAll()
+
+	// The request sent again after the new login waits for the limiter too.
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if stats := c.LimiterStats(); stats.Requests != 3 || stats.InFlight != 0 {
+		t.Fatalf("expected 3 requests admitted and none in flight, got %+v", stats)
+	}
+}
+
+func TestTokenAuth_BadCredentials(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+	p := pool.New(soap.NewClient(s.portal(), soap.WithTokenAuth(soap.StaticCredentials("admin", "wrong"))))
+
+	_, err := p.GetTTL([]string{"/Common/pool1"})
+
+	httpErr, ok := err.(*soap.HTTPError)
+	if !ok || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 HTTPError, got %v", err)
+	}
+}
+
+func TestCredentialProviders(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+
+	const userVar, passVar = "GO_F5_SOAP_TEST_USER", "GO_F5_SOAP_TEST_PASSWORD"
+	_ = os.Setenv(userVar, "env-user")
+	_ = os.Setenv(passVar, "admin")
+	defer os.Unsetenv(userVar)
+	defer os.Unsetenv(passVar)
+
+	file := filepath.Join(t.TempDir(), "credentials.json")
+	if err := ioutil.WriteFile(file, []byte(`{"username":"file-user","password":"admin","login_provider":"ldap"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	providers := map[string]soap.CredentialProvider{
+		"env-user:admin@tmos":  soap.EnvCredentials(userVar, passVar),
+		"file-user:admin@ldap": soap.FileCredentials(file),
+		"func-user:admin@tmos": soap.CredentialsFunc(func(context.Context) (soap.Credentials, error) {
+			return soap.Credentials{Username: "func-user", Password: "admin"}, nil
+		}),
+	}
+
+	for want, provider := range providers {
+		p := pool.New(soap.NewClient(s.portal(), soap.WithTokenAuth(provider)))
+		if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+			t.Fatalf("%s: %v", want, err)
+		}
+		if got := s.creds[len(s.creds)-1]; got != want {
+			t.Fatalf("got login %q, want %q", got, want)
+		}
+	}
+
+	if _, err := soap.EnvCredentials("GO_F5_SOAP_TEST_UNSET", passVar).Credentials(context.Background()); err == nil {
+		t.Fatal("expected an error for an unset variable")
+	}
+}
+
+func TestBasicAuthProvider(t *testing.T) {
+
+	s := newAuthServer(t, 1200)
+
+	n := 0
+	provider := soap.CredentialsFunc(func(context.Context) (soap.Credentials, error) {
+		n++
+		return soap.Credentials{Username: "admin", Password: fmt.Sprintf("secret-%d", n)}, nil
+	})
+	p := pool.New(soap.NewClient(s.portal(), soap.WithCredentials(provider)))
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(s.basic) != 2 || s.basic[0] != "admin:secret-1" || s.basic[1] != "admin:secret-2" {
+		t.Fatalf("expected credentials to be fetched for each request, got %v", s.basic)
+	}
+}
@@ -0,0 +1,156 @@
+// Package batch provides generic helpers for the batched, parallel-array calls of iControl,
+// e.g. splitting 20k pool names into calls of 500 and zipping the names with the returned statuses.
+//
+//	status, err := batch.MapChunks(ctx, names, 500, 4, func(ctx context.Context, names []string) ([]common.ObjectStatus, error) {
+//		return p.GetObjectStatusCtx(ctx, names)
+//	})
+//	byName, err := batch.ZipMap(names, status)
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// ErrLengthMismatch is returned when parallel arrays differ in length.
+var ErrLengthMismatch = errors.New("batch: parallel arrays differ in length")
+
+// Chunk splits s in slices of at most size elements, in order. The slices share the array of s.
+// It returns nil for an empty s, and s as a single chunk when size is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+
+	if len(s) == 0 {
+		return nil
+	}
+	if size <= 0 || size >= len(s) {
+		return [][]T{s}
+	}
+
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for lo := 0; lo < len(s); lo += size {
+		hi := lo + size
+		if hi > len(s) {
+			hi = len(s)
+		}
+		chunks = append(chunks, s[lo:hi:hi])
+	}
+	return chunks
+}
+
+// ParallelMap applies fn to the elements of in, at most limit at once (unbounded when not positive),
+// and returns the results in the order of in.
+// The first error cancels the context passed to the other calls and is returned.
+func ParallelMap[T, R any](ctx context.Context, in []T, limit int, fn func(ctx context.Context, v T) (R, error)) ([]R, error) {
+
+	out := make([]R, len(in))
+	g, ctx := errgroup.WithContext(ctx)
+
+	var sem chan struct{}
+	if limit > 0 {
+		sem = make(chan struct{}, limit)
+	}
+
+	for i, v := range in {
+		i, v := i, v
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				// The error of the failed call or of the parent context is returned by Wait.
+				if err := g.Wait(); err != nil {
+					return nil, err
+				}
+				return nil, ctx.Err()
+			}
+		}
+
+		g.Go(func() error {
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+			r, err := fn(ctx, v)
+			if err != nil {
+				return err
+			}
+			out[i] = r
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MapChunks splits in in chunks of size elements, calls fn on them with ParallelMap
+// and returns the concatenated results, in the order of in.
+// fn must return one result per element of its chunk.
+func MapChunks[T, R any](ctx context.Context, in []T, size, limit int, fn func(ctx context.Context, chunk []T) ([]R, error)) ([]R, error) {
+
+	chunks := Chunk(in, size)
+	results, err := ParallelMap(ctx, chunks, limit, func(ctx context.Context, chunk []T) ([]R, error) {
+		r, err := fn(ctx, chunk)
+		if err == nil && len(r) != len(chunk) {
+			return nil, fmt.Errorf("%w: %d results for %d elements", ErrLengthMismatch, len(r), len(chunk))
+		}
+		return r, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]R, 0, len(in))
+	for _, r := range results {
+		out = append(out, r...)
+	}
+	return out, nil
+}
+
+// Pair is an element of two parallel arrays.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs the elements of the parallel arrays a and b, e.g. pool names and their members.
+func Zip[A, B any](a []A, b []B) ([]Pair[A, B], error) {
+
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(a), len(b))
+	}
+
+	pairs := make([]Pair[A, B], len(a))
+	for i := range a {
+		pairs[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return pairs, nil
+}
+
+// Unzip splits pairs in two parallel arrays, the shape of iControl requests.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+	return a, b
+}
+
+// ZipMap maps the keys to the values of parallel arrays, e.g. pool names to the ratios of their members.
+// The last value wins for duplicated keys.
+func ZipMap[K comparable, V any](keys []K, values []V) (map[K]V, error) {
+
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(keys), len(values))
+	}
+
+	m := make(map[K]V, len(keys))
+	for i, k := range keys {
+		m[k] = values[i]
+	}
+	return m, nil
+}
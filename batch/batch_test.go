@@ -0,0 +1,144 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+func TestChunk(t *testing.T) {
+
+	s := []string{"a", "b", "c", "d", "e"}
+
+	if want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}; !reflect.DeepEqual(Chunk(s, 2), want) {
+		t.Fatalf("expected %v, got %v", want, Chunk(s, 2))
+	}
+	if want := [][]string{s}; !reflect.DeepEqual(Chunk(s, 0), want) || !reflect.DeepEqual(Chunk(s, 10), want) {
+		t.Fatalf("expected %v, got %v", want, Chunk(s, 0))
+	}
+	if Chunk([]string(nil), 2) != nil {
+		t.Fatal("expected no chunk")
+	}
+
+	// Appending to a chunk does not overwrite the next one.
+	chunks := Chunk(s, 2)
+	_ = append(chunks[0], "x")
+	if chunks[1][0] != "c" {
+		t.Fatalf("the next chunk was overwritten: %v", chunks)
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+
+	var mu sync.Mutex
+	inFlight, max := 0, 0
+	out, err := ParallelMap(context.Background(), []int{1, 2, 3, 4, 5, 6}, 2, func(ctx context.Context, v int) (int, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > max {
+			max = inFlight
+		}
+		mu.Unlock()
+
+		// The later elements finish first.
+		time.Sleep(time.Duration(7-v) * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return v * v, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 4, 9, 16, 25, 36}; !reflect.DeepEqual(out, want) {
+		t.Fatalf("expected %v, got %v", want, out)
+	}
+	if max != 2 {
+		t.Fatalf("expected 2 concurrent calls, got %d", max)
+	}
+
+	boom := errors.New("boom")
+	_, err = ParallelMap(context.Background(), []int{1, 2, 3}, 0, func(ctx context.Context, v int) (int, error) {
+		if v == 2 {
+			return 0, boom
+		}
+		return v, nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected %v, got %v", boom, err)
+	}
+}
+
+func TestMapChunks(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(
+		f5test.Pool{Name: "/Common/pool1", TTL: 10},
+		f5test.Pool{Name: "/Common/pool2", TTL: 20},
+		f5test.Pool{Name: "/Common/pool3", TTL: 30},
+	)
+	p := pool.New(s.Client())
+
+	names := []string{"/Common/pool3", "/Common/pool1", "/Common/pool2"}
+	ttl, err := MapChunks(context.Background(), names, 2, 2, func(ctx context.Context, names []string) ([]int64, error) {
+		return p.GetTTLCtx(ctx, names)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{30, 10, 20}; !reflect.DeepEqual(ttl, want) {
+		t.Fatalf("expected %v, got %v", want, ttl)
+	}
+
+	byName, err := ZipMap(names, ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byName["/Common/pool1"] != 10 {
+		t.Fatalf("unexpected map %v", byName)
+	}
+
+	_, err = MapChunks(context.Background(), names, 2, 1, func(ctx context.Context, names []string) ([]int64, error) {
+		return nil, nil
+	})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("expected ErrLengthMismatch, got %v", err)
+	}
+}
+
+func TestZip(t *testing.T) {
+
+	names := []string{"/Common/pool1", "/Common/pool2"}
+	members := [][]common.IPPortDefinition{
+		{{Address: "10.0.0.1", Port: 80}},
+		{{Address: "10.0.0.2", Port: 80}, {Address: "10.0.0.3", Port: 80}},
+	}
+
+	pairs, err := Zip(names, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pairs[1].First != "/Common/pool2" || len(pairs[1].Second) != 2 {
+		t.Fatalf("unexpected pairs %+v", pairs)
+	}
+
+	a, b := Unzip(pairs)
+	if !reflect.DeepEqual(a, names) || !reflect.DeepEqual(b, members) {
+		t.Fatalf("expected %v %v, got %v %v", names, members, a, b)
+	}
+
+	if _, err := Zip(names, members[:1]); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("expected ErrLengthMismatch, got %v", err)
+	}
+	if _, err := ZipMap(names, []int{1}); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("expected ErrLengthMismatch, got %v", err)
+	}
+}
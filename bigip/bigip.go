@@ -6,12 +6,14 @@ import (
 	"github.com/wule61/go-f5-soap/global_lb/monitor"
 	"github.com/wule61/go-f5-soap/global_lb/pool"
 	"github.com/wule61/go-f5-soap/global_lb/pool_member"
+	"github.com/wule61/go-f5-soap/global_lb/pool_member_v2"
 	"github.com/wule61/go-f5-soap/global_lb/pool_v2"
 	"github.com/wule61/go-f5-soap/global_lb/virtual_server"
 	"github.com/wule61/go-f5-soap/global_lb/virtual_server_v2"
 	"github.com/wule61/go-f5-soap/management/resource_record"
 	"github.com/wule61/go-f5-soap/management/view"
 	"github.com/wule61/go-f5-soap/management/zone"
+	"github.com/wule61/go-f5-soap/system/session"
 	"github.com/wule61/go-f5-soap/system/system_info"
 )
 
@@ -22,6 +24,7 @@ import (
 type GlobalLB struct {
 	Pool            pool.IPool
 	PoolMember      pool_member.IPoolMember
+	PoolMemberV2    pool_member_v2.IPoolMemberV2
 	PoolV2          pool_v2.IPoolV2
 	Monitor         monitor.IMonitor
 	VirtualServer   virtual_server.IVirtualServer
@@ -40,6 +43,7 @@ type Management struct {
 
 type System struct {
 	SystemInfo system_info.ISystemInfo
+	Session    session.ISession
 }
 
 type BigIP struct {
@@ -54,6 +58,7 @@ func New(c *soap.Client) *BigIP {
 		GlobalLB: &GlobalLB{
 			Pool:            pool.New(c),
 			PoolMember:      pool_member.New(c),
+			PoolMemberV2:    pool_member_v2.New(c),
 			PoolV2:          pool_v2.New(c),
 			Monitor:         monitor.New(c),
 			VirtualServer:   virtual_server.New(c),
@@ -67,6 +72,7 @@ func New(c *soap.Client) *BigIP {
 		},
 		System: &System{
 			SystemInfo: system_info.New(c),
+			Session:    session.New(c),
 		},
 	}
 
@@ -0,0 +1,306 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// CassetteVersion is the version of the cassette file format written by a Recorder.
+const CassetteVersion = 1
+
+// ErrNoInteraction is returned by a replaying client for a call missing from its cassette.
+var ErrNoInteraction = errors.New("icontrol: no recorded interaction")
+
+// scrubbedValue replaces the scrubbed values in cassettes.
+const scrubbedValue = "REDACTED"
+
+// DefaultScrubbedFields are the elements whose values are never written to a cassette.
+var DefaultScrubbedFields = []string{"password", "passphrase", "secret", "token"}
+
+// Cassette is a set of recorded SOAP calls.
+type Cassette struct {
+	Version      int           `json:"version"`            // The version of the file format, CassetteVersion.
+	Scrubbed     []string      `json:"scrubbed,omitempty"` // The elements scrubbed from the recorded envelopes.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded call.
+type Interaction struct {
+	Namespace  string `json:"namespace"`   // The tns of the call, e.g. urn:iControl:GlobalLB/Pool.
+	Operation  string `json:"operation"`   // The method name, e.g. get_member_v2.
+	Request    string `json:"request"`     // The normalized request envelope.
+	StatusCode int    `json:"status_code"` // The HTTP status of the response.
+	Response   string `json:"response"`    // The response envelope.
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+
+	bt, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(bt, &c); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	if c.Version != CassetteVersion {
+		return nil, fmt.Errorf("cassette %s: unsupported version %d", path, c.Version)
+	}
+
+	return &c, nil
+}
+
+// Save writes the cassette to path.
+func (c *Cassette) Save(path string) error {
+
+	bt, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(bt, '\n'), 0o644)
+}
+
+// Recorder captures the calls of the clients created WithRecorder into a cassette.
+// Credentials are never recorded, and the values of the scrubbed elements
+// and of the secret monitor properties are replaced.
+type Recorder struct {
+	path   string
+	fields []string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a recorder saving to path, scrubbing DefaultScrubbedFields
+// and the elements named in fields, e.g. description.
+func NewRecorder(path string, fields ...string) *Recorder {
+	fields = append(append([]string(nil), DefaultScrubbedFields...), fields...)
+	return &Recorder{
+		path:     path,
+		fields:   fields,
+		cassette: Cassette{Version: CassetteVersion, Scrubbed: fields},
+	}
+}
+
+// WithRecorder records the calls of the client with r.
+func WithRecorder(r *Recorder) Option {
+	return func(o *options) {
+		o.recorder = r
+	}
+}
+
+// Cassette returns a copy of the calls recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := r.cassette
+	c.Interactions = append([]Interaction(nil), r.cassette.Interactions...)
+	return &c
+}
+
+// Save writes the calls recorded so far to the cassette file.
+func (r *Recorder) Save() error {
+	return r.Cassette().Save(r.path)
+}
+
+// client returns next recording its SOAP calls.
+func (r *Recorder) client(next HTTPClient) HTTPClient {
+	return &recordingClient{r: r, next: next}
+}
+
+type recordingClient struct {
+	r    *Recorder
+	next HTTPClient
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+
+	if req.URL.Path == loginPath || req.Body == nil {
+		return c.next.Do(req)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	res, err := c.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	response, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(response))
+
+	op := parseOperation(body)
+	c.r.mu.Lock()
+	c.r.cassette.Interactions = append(c.r.cassette.Interactions, Interaction{
+		Namespace:  op.Namespace,
+		Operation:  op.Name,
+		Request:    normalizeEnvelope(redactSecrets(body), c.r.fields),
+		StatusCode: res.StatusCode,
+		Response:   string(redactSecrets(scrub(response, c.r.fields))),
+	})
+	c.r.mu.Unlock()
+
+	return res, nil
+}
+
+// Replayer answers the calls of the clients created WithReplayer from a cassette, without a device.
+// A call is matched on its namespace, operation and normalized envelope; the recorded
+// interactions of a call are replayed in order, the last one answering again once all were used.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer returns a replayer of the cassette file at path.
+func NewReplayer(path string) (*Replayer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCassetteReplayer(c), nil
+}
+
+// NewCassetteReplayer returns a replayer of c.
+func NewCassetteReplayer(c *Cassette) *Replayer {
+	return &Replayer{cassette: c, used: make([]bool, len(c.Interactions))}
+}
+
+// WithReplayer answers the calls of the client from r instead of sending them to the device.
+// It replaces WithHTTPClient.
+func WithReplayer(r *Replayer) Option {
+	return func(o *options) {
+		o.client = r
+	}
+}
+
+// Do answers req from the cassette. Token logins always succeed.
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+
+	if req.URL.Path == loginPath {
+		return replayResponse(req, http.StatusOK, "application/json", `{"token":{"token":"REPLAY","timeout":1200}}`), nil
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	op := parseOperation(body)
+	request := normalizeEnvelope(redactSecrets(body), r.cassette.Scrubbed)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, it := range r.cassette.Interactions {
+		if it.Namespace != op.Namespace || it.Operation != op.Name || it.Request != request {
+			continue
+		}
+		last = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, op.Namespace, op.Name)
+	}
+	r.used[last] = true
+
+	it := r.cassette.Interactions[last]
+	return replayResponse(req, it.StatusCode, "text/xml; charset=utf-8", it.Response), nil
+}
+
+func replayResponse(req *http.Request, status int, contentType, body string) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {contentType}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// normalizeEnvelope renders an envelope without namespaces, attributes and
+// indentation, with the values of fields scrubbed, so that equivalent envelopes compare equal.
+func normalizeEnvelope(body []byte, fields []string) string {
+
+	var b strings.Builder
+	var stack []string
+
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			b.WriteString("<" + t.Name.Local + ">")
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+			b.WriteString("</" + t.Name.Local + ">")
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text == "" {
+				continue
+			}
+			if len(stack) > 0 && scrubbed(stack[len(stack)-1], fields) {
+				text = scrubbedValue
+			}
+			_ = xml.EscapeText(&b, []byte(text))
+		}
+	}
+
+	return b.String()
+}
+
+func scrubbed(name string, fields []string) bool {
+	for _, f := range fields {
+		if strings.EqualFold(name, f) {
+			return true
+		}
+	}
+	return false
+}
+
+var elementRe = regexp.MustCompile(`(<(?:[\w.-]+:)?([\w.-]+)(?:\s[^>]*)?>)([^<]*)(</)`)
+
+// scrub replaces the values of the elements named in fields.
+func scrub(body []byte, fields []string) []byte {
+	return elementRe.ReplaceAllFunc(body, func(m []byte) []byte {
+		sub := elementRe.FindSubmatch(m)
+		if !scrubbed(string(sub[2]), fields) || len(bytes.TrimSpace(sub[3])) == 0 {
+			return m
+		}
+		return append(append(append([]byte(nil), sub[1]...), scrubbedValue...), sub[4]...)
+	})
+}
@@ -0,0 +1,119 @@
+package soap_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+	"github.com/wule61/go-f5-soap/management"
+	"github.com/wule61/go-f5-soap/management/zone"
+)
+
+func TestCassette_RecordReplay(t *testing.T) {
+
+	s := f5test.NewServer(t, f5test.WithCredentials("recorder", "s3cret"))
+	s.AddPools(f5test.Pool{Name: "/Common/pool1", TTL: 60})
+
+	path := filepath.Join(t.TempDir(), "pool.json")
+	rec := soap.NewRecorder(path)
+
+	p := pool.New(s.Client(soap.WithRecorder(rec)))
+	ttl, err := p.GetTTL([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetTTL([]string{"/Common/pool2"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	bt, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bt), "s3cret") {
+		t.Fatal("the credentials were recorded")
+	}
+
+	replayer, err := soap.NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The replaying client never reaches the device.
+	p = pool.New(soap.NewClient("https://192.0.2.1/iControl/iControlPortal.cgi", soap.WithReplayer(replayer)))
+
+	got, err := p.GetTTL([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, ttl) {
+		t.Fatalf("expected %v, got %v", ttl, got)
+	}
+
+	// Replayed calls can be repeated.
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.GetTTL([]string{"/Common/pool2"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+
+	if _, err := p.GetTTL([]string{"/Common/pool3"}); !errors.Is(err, soap.ErrNoInteraction) {
+		t.Fatalf("expected ErrNoInteraction, got %v", err)
+	}
+	if _, err := p.GetList(); !errors.Is(err, soap.ErrNoInteraction) {
+		t.Fatalf("expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestCassette_Scrub(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddViews(management.ViewInfo{ViewName: "external"})
+	s.AddZones(f5test.Zone{ZoneInfo: management.ZoneInfo{
+		ViewName: "external",
+		ZoneName: "example.com.",
+		ZoneType: management.MASTER,
+		ZoneFile: "db.external.example.com.",
+	}})
+
+	rec := soap.NewRecorder(filepath.Join(t.TempDir(), "zone.json"), "zone_file")
+
+	viewZones := []management.ViewZone{{ViewName: "external", ZoneName: "example.com."}}
+	if _, err := zone.New(s.Client(soap.WithRecorder(rec))).GetZoneV2(viewZones); err != nil {
+		t.Fatal(err)
+	}
+
+	c := rec.Cassette()
+	if c.Version != soap.CassetteVersion || len(c.Interactions) != 1 {
+		t.Fatalf("unexpected cassette %+v", c)
+	}
+	it := c.Interactions[0]
+	if it.Namespace != "urn:iControl:Management/Zone" || it.Operation != "get_zone_v2" {
+		t.Fatalf("unexpected interaction %+v", it)
+	}
+	if strings.Contains(it.Response, "db.external.example.com.") {
+		t.Fatalf("zone_file was not scrubbed: %s", it.Response)
+	}
+
+	res, err := zone.New(soap.NewClient("https://192.0.2.1/iControl/iControlPortal.cgi",
+		soap.WithReplayer(soap.NewCassetteReplayer(c)),
+		soap.WithTokenAuth(soap.StaticCredentials("admin", "admin")),
+	)).GetZoneV2(viewZones)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].ZoneName != "example.com." || res[0].ZoneFile != "REDACTED" {
+		t.Fatalf("unexpected zones %+v", res)
+	}
+}
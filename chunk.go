@@ -0,0 +1,309 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// ChunkPolicy describes how the batched getters are split.
+// A get_* call whose first parameter has more than Size items is sent in chunks of Size items,
+// every parameter with as many items as the first one being split alongside it.
+// The chunks run concurrently, and the items returned by the device are reassembled in the input order.
+type ChunkPolicy struct {
+	Size        int // Maximum number of items per call, no chunking when zero.
+	Parallelism int // Maximum number of chunks sent at once, one when zero.
+}
+
+// DefaultChunkPolicy sends up to 4 chunks of 500 items at once.
+var DefaultChunkPolicy = ChunkPolicy{Size: 500, Parallelism: 4}
+
+// WithChunking splits the batched getters of the client following p.
+func WithChunking(p ChunkPolicy) Option {
+	return func(o *options) {
+		o.chunking = &p
+	}
+}
+
+type chunkCtxKey int
+
+const chunkSizeKey chunkCtxKey = iota
+
+// ContextWithChunkSize overrides ChunkPolicy.Size for the calls made with the returned context.
+func ContextWithChunkSize(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, chunkSizeKey, n)
+}
+
+func (p *ChunkPolicy) size(ctx context.Context) int {
+	if n, ok := ctx.Value(chunkSizeKey).(int); ok {
+		return n
+	}
+	return p.Size
+}
+
+// ChunkError reports the chunks of a call which failed. The other chunks succeeded,
+// but Call returns none of their items, which would not line up with the input.
+type ChunkError struct {
+	Items  int            // The number of items of the call.
+	Failed []ChunkFailure // The failed chunks, in the input order.
+}
+
+// ChunkFailure is a failed chunk of a call.
+type ChunkFailure struct {
+	Offset int // The index of the first item of the chunk in the input.
+	Count  int // The number of items of the chunk.
+	Err    error
+}
+
+func (e *ChunkError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, f := range e.Failed {
+		msgs[i] = fmt.Sprintf("items %d-%d: %v", f.Offset, f.Offset+f.Count-1, f.Err)
+	}
+	return fmt.Sprintf("icontrol: %d chunk(s) of %d items failed: %s", len(e.Failed), e.Items, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the error of the first failed chunk, so that IsNotFound and AsFault see it.
+func (e *ChunkError) Unwrap() error {
+	return e.Failed[0].Err
+}
+
+// chunk is a part of a batched call.
+type chunk struct {
+	offset int
+	count  int
+	body   []byte
+}
+
+// callChunks sends the chunks of a call and merges their responses.
+func (c *Client) callChunks(ctx context.Context, op operation, chunks []chunk) ([]byte, error) {
+
+	parallelism := c.opts.chunking.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	sem := semaphore.NewWeighted(int64(parallelism))
+
+	responses := make([][]byte, len(chunks))
+	errs := make([]error, len(chunks))
+
+	var g errgroup.Group
+	for i := range chunks {
+		i := i
+		if err := sem.Acquire(ctx, 1); err != nil {
+			errs[i] = err
+			continue
+		}
+		g.Go(func() error {
+			defer sem.Release(1)
+			responses[i], errs[i] = c.send(ctx, op, chunks[i].body)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	last := chunks[len(chunks)-1]
+	chunkErr := &ChunkError{Items: last.offset + last.count}
+	for i, err := range errs {
+		if err != nil {
+			chunkErr.Failed = append(chunkErr.Failed, ChunkFailure{Offset: chunks[i].offset, Count: chunks[i].count, Err: err})
+		}
+	}
+	if len(chunkErr.Failed) > 0 {
+		return nil, chunkErr
+	}
+
+	return mergeResponses(responses)
+}
+
+// element locates an element in an encoded document.
+type element struct {
+	name         string
+	start        int // The offset of the start tag.
+	contentStart int // The offset following the start tag.
+	contentEnd   int // The offset of the end tag.
+	end          int // The offset following the end tag.
+	children     []*element
+}
+
+// selfClosing reports whether the element was written as <name/>.
+func (e *element) selfClosing() bool {
+	return e.contentStart == e.end
+}
+
+// parseElements returns the elements of doc down to the given depth, the root at depth 1.
+func parseElements(doc []byte, depth int) (*element, error) {
+
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	var root *element
+	var stack []*element
+	for {
+		offset := int(dec.InputOffset())
+		tok, err := dec.RawToken()
+		if err != nil {
+			if err == io.EOF && root != nil && len(stack) == 0 {
+				return root, nil
+			}
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{name: t.Name.Local, start: offset, contentStart: int(dec.InputOffset())}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errors.New("multiple root elements")
+				}
+				root = e
+			} else if len(stack) < depth {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, errors.New("unexpected end element")
+			}
+			e := stack[len(stack)-1]
+			e.contentEnd, e.end = offset, int(dec.InputOffset())
+			if e.end == e.contentEnd {
+				// <name/> yields its end element without reading more input.
+				e.contentEnd = e.contentStart
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// operationElement returns the operation element of an envelope: Envelope > Body > operation.
+func operationElement(root *element) *element {
+	for _, b := range root.children {
+		if b.name == "Body" && len(b.children) > 0 {
+			return b.children[0]
+		}
+	}
+	return nil
+}
+
+// splitEnvelope splits a request envelope whose first parameter has more than size items.
+// It returns nil when the envelope is not split.
+func splitEnvelope(body []byte, size int) []chunk {
+
+	if size <= 0 {
+		return nil
+	}
+
+	root, err := parseElements(body, 5)
+	if err != nil {
+		return nil
+	}
+	op := operationElement(root)
+	if op == nil || len(op.children) == 0 {
+		return nil
+	}
+	n := len(op.children[0].children)
+	if n <= size {
+		return nil
+	}
+
+	var chunks []chunk
+	for lo := 0; lo < n; lo += size {
+		hi := lo + size
+		if hi > n {
+			hi = n
+		}
+
+		var b bytes.Buffer
+		b.Write(body[:op.contentStart])
+		prev := op.contentStart
+		for _, p := range op.children {
+			b.Write(body[prev:p.start])
+			if len(p.children) == n && !p.selfClosing() {
+				b.Write(body[p.start:p.contentStart])
+				for _, item := range p.children[lo:hi] {
+					b.Write(body[item.start:item.end])
+				}
+				b.Write(body[p.contentEnd:p.end])
+			} else {
+				b.Write(body[p.start:p.end])
+			}
+			prev = p.end
+		}
+		b.Write(body[prev:])
+
+		chunks = append(chunks, chunk{offset: lo, count: hi - lo, body: b.Bytes()})
+	}
+
+	return chunks
+}
+
+// mergeResponses concatenates the items returned in the responses of the chunks of a call,
+// into the first response: Envelope > Body > operationResponse > return.
+// It fails when a return is not an array, whose items could not be concatenated.
+func mergeResponses(responses [][]byte) ([]byte, error) {
+
+	var first *element
+	var content bytes.Buffer
+	for i, r := range responses {
+		root, err := parseElements(r, 5)
+		if err != nil {
+			return nil, err
+		}
+
+		var ret *element
+		if op := operationElement(root); op != nil {
+			for _, e := range op.children {
+				if e.name == "return" {
+					ret = e
+				}
+			}
+		}
+		if ret == nil {
+			return nil, errors.New("icontrol: no return element in the response of a chunk")
+		}
+		if !isArray(r, ret) {
+			return nil, errors.New("icontrol: the return element of a chunk is not an array")
+		}
+
+		if i == 0 {
+			first = ret
+		}
+		content.Write(r[ret.contentStart:ret.contentEnd])
+	}
+
+	r := responses[0]
+	var b bytes.Buffer
+	if first.selfClosing() {
+		b.Write(r[:first.start])
+		b.WriteString("<return>")
+		b.Write(content.Bytes())
+		b.WriteString("</return>")
+	} else {
+		b.Write(r[:first.contentStart])
+		b.Write(content.Bytes())
+		b.Write(r[first.contentEnd:first.end])
+	}
+	b.Write(r[first.end:])
+
+	return b.Bytes(), nil
+}
+
+// isArray reports whether the element e of doc is empty or only holds item elements.
+func isArray(doc []byte, e *element) bool {
+	if len(e.children) == 0 {
+		return len(bytes.TrimSpace(doc[e.contentStart:e.contentEnd])) == 0
+	}
+	for _, c := range e.children {
+		if c.name != "item" {
+			return false
+		}
+	}
+	return true
+}
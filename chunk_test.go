@@ -0,0 +1,114 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+	"github.com/wule61/go-f5-soap/global_lb/pool_member"
+)
+
+func TestChunking(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	var names []string
+	var want []int64
+	for i := 0; i < 7; i++ {
+		name := fmt.Sprintf("/Common/pool%d", i)
+		s.AddPools(f5test.Pool{Name: name, TTL: int64(10 + i)})
+		names, want = append(names, name), append(want, int64(10+i))
+	}
+
+	p := pool.New(s.Client(soap.WithChunking(soap.ChunkPolicy{Size: 3, Parallelism: 2})))
+
+	ttl, err := p.GetTTL(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ttl, want) {
+		t.Fatalf("expected %v, got %v", want, ttl)
+	}
+	if n := served(s, "get_ttl"); n != 3 {
+		t.Fatalf("expected 3 chunks, got %d", n)
+	}
+
+	// The chunk size can be overridden per call.
+	if _, err := p.GetTTLCtx(soap.ContextWithChunkSize(context.Background(), 0), names); err != nil {
+		t.Fatal(err)
+	}
+	if n := served(s, "get_ttl"); n != 4 {
+		t.Fatalf("expected 1 more call, got %d", n-3)
+	}
+
+	// The chunks failing are reported, the others succeed.
+	names[4] = "/Common/missing"
+	_, err = p.GetTTL(names)
+	var chunkErr *soap.ChunkError
+	if !errors.As(err, &chunkErr) || !soap.IsNotFound(err) {
+		t.Fatalf("expected a ChunkError, got %v", err)
+	}
+	if want := []soap.ChunkFailure{{Offset: 3, Count: 3, Err: chunkErr.Failed[0].Err}}; chunkErr.Items != 7 || !reflect.DeepEqual(chunkErr.Failed, want) {
+		t.Fatalf("expected %+v, got %+v", want, chunkErr.Failed)
+	}
+}
+
+func TestChunking_ScalarReturn(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body><m:get_ttlResponse xmlns:m="urn:iControl:GlobalLB/Pool"><return>5</return></m:get_ttlResponse></SOAP-ENV:Body></SOAP-ENV:Envelope>`))
+	}))
+	t.Cleanup(srv.Close)
+
+	// Only arrays are merged, a scalar return cannot be reassembled.
+	p := pool.New(soap.NewClient(srv.URL, soap.WithChunking(soap.ChunkPolicy{Size: 1})))
+	if _, err := p.GetTTL([]string{"/Common/pool1", "/Common/pool2"}); err == nil || !strings.Contains(err.Error(), "not an array") {
+		t.Fatalf("expected the return to be rejected, got %v", err)
+	}
+}
+
+func TestChunking_ParallelArrays(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	var names []string
+	var members [][]common.IPPortDefinition
+	for i := 0; i < 5; i++ {
+		vs := f5test.VirtualServer{
+			Name:        fmt.Sprintf("vs%d", i),
+			Server:      "/Common/bigip1",
+			Destination: common.IPPortDefinition{Address: fmt.Sprintf("10.0.0.%d", i), Port: 80},
+		}
+		s.AddVirtualServers(vs)
+		s.AddPools(f5test.Pool{
+			Name:    fmt.Sprintf("/Common/pool%d", i),
+			Members: []f5test.PoolMember{{Name: vs.Name, Server: vs.Server, Ratio: int64(i + 1)}},
+		})
+		names = append(names, fmt.Sprintf("/Common/pool%d", i))
+		members = append(members, []common.IPPortDefinition{vs.Destination})
+	}
+
+	want, err := pool_member.New(s.Client()).GetRatio(names, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := pool_member.New(s.Client(soap.WithChunking(soap.ChunkPolicy{Size: 2})))
+	ratio, err := m.GetRatio(names, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ratio) != 5 || !reflect.DeepEqual(ratio, want) {
+		t.Fatalf("expected %v, got %v", want, ratio)
+	}
+	if n := served(s, "get_ratio"); n != 4 {
+		t.Fatalf("expected 3 chunks, got %d", n-1)
+	}
+}
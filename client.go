@@ -5,16 +5,19 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/xml"
+	"errors"
 	"fmt"
-	"github.com/FishGoddess/logit"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"time"
 )
 
 type Option func(*options)
 
+const sessionHeader = "X-iControl-Session"
+
 func WithRequestTimeout(t time.Duration) Option {
 	return func(o *options) {
 		o.conTimeout = t
@@ -22,9 +25,7 @@ func WithRequestTimeout(t time.Duration) Option {
 }
 
 func WithBasicAuth(login, password string) Option {
-	return func(o *options) {
-		o.auth = &basicAuth{Login: login, Password: password}
-	}
+	return WithCredentials(StaticCredentials(login, password))
 }
 
 func WithTLS(tls *tls.Config) Option {
@@ -45,31 +46,70 @@ func WithHTTPHeaders(headers map[string]string) Option {
 	}
 }
 
+// WithDebug logs the request and response envelopes of every call, with their secrets redacted,
+// to the logger of the client or to the standard error.
 func WithDebug() Option {
 	return func(o *options) {
 		o.debug = true
 	}
 }
 
+// WithHTTPClient makes the client send its requests through c instead of
+// building its own transport. Transport related options are ignored then.
+func WithHTTPClient(c HTTPClient) Option {
+	return func(o *options) {
+		o.client = c
+	}
+}
+
+// WithMaxIdleConnsPerHost sets how many idle keep-alive connections are kept to the device.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(o *options) {
+		o.maxIdleConnsPerHost = n
+	}
+}
+
+// WithMaxConnsPerHost limits the total number of connections to the device, zero means no limit.
+func WithMaxConnsPerHost(n int) Option {
+	return func(o *options) {
+		o.maxConnsPerHost = n
+	}
+}
+
+// WithIdleConnTimeout sets how long an idle keep-alive connection is kept before being closed.
+func WithIdleConnTimeout(t time.Duration) Option {
+	return func(o *options) {
+		o.idleConnTimeout = t
+	}
+}
+
 type options struct {
 	tlsCfg           *tls.Config
-	auth             *basicAuth
+	credentials      CredentialProvider
+	tokenAuth        bool
+	tokenMargin      time.Duration
 	client           HTTPClient
 	timeout          time.Duration
 	conTimeout       time.Duration
 	tlsHShakeTimeout time.Duration
 	httpHeaders      map[string]string
 	debug            bool
+	retry            *RetryPolicy
+	recorder         *Recorder
+	logger           Logger
+	hooks            []Hooks
+	instrumentation  []Instrumentation
+	limiter          *Limiter
+	chunking         *ChunkPolicy
+
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	idleConnTimeout     time.Duration
 }
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
-type basicAuth struct {
-	Login    string
-	Password string
-}
-
 type HTTPError struct {
 	StatusCode   int
 	ResponseBody []byte
@@ -80,15 +120,22 @@ func (e *HTTPError) Error() string {
 }
 
 var defaultOptions = options{
-	timeout:          time.Duration(30 * time.Second),
-	conTimeout:       time.Duration(90 * time.Second),
-	tlsHShakeTimeout: time.Duration(15 * time.Second),
+	timeout:             time.Duration(30 * time.Second),
+	conTimeout:          time.Duration(90 * time.Second),
+	tlsHShakeTimeout:    time.Duration(15 * time.Second),
+	maxIdleConnsPerHost: 10,
+	idleConnTimeout:     time.Duration(90 * time.Second),
+	tokenMargin:         time.Duration(time.Minute),
 }
 
 type Client struct {
 	url     string
 	opts    *options
 	headers []interface{}
+	client  HTTPClient
+	tokens  *tokenSource
+	session string
+	group   *endpointGroup
 }
 
 func NewClient(url string, opt ...Option) *Client {
@@ -99,14 +146,99 @@ func NewClient(url string, opt ...Option) *Client {
 		o(&opts)
 	}
 
-	return &Client{
-		url:  url,
-		opts: &opts,
+	client := opts.client
+	if client == nil {
+		client = newHTTPClient(&opts)
+	}
+	if opts.recorder != nil {
+		client = opts.recorder.client(client)
+	}
+
+	if opts.debug && opts.logger == nil {
+		opts.logger = newStdLogger()
+	}
+	if opts.logger != nil {
+		opts.hooks = append(append([]Hooks(nil), opts.hooks...), logHooks(opts.logger))
+	}
+
+	c := &Client{
+		url:    url,
+		opts:   &opts,
+		client: client,
+	}
+	if opts.tokenAuth && opts.credentials != nil {
+		c.tokens = newTokenSource(url, &opts, client)
+	}
+
+	return c
+}
+
+// newHTTPClient builds the http.Client shared by every call of a Client,
+// so that connections to the device are kept alive and reused.
+func newHTTPClient(opts *options) *http.Client {
+	dialer := &net.Dialer{Timeout: opts.timeout, KeepAlive: 30 * time.Second}
+
+	tr := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSClientConfig:     opts.tlsCfg,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: opts.tlsHShakeTimeout,
+		MaxIdleConns:        opts.maxIdleConnsPerHost,
+		MaxIdleConnsPerHost: opts.maxIdleConnsPerHost,
+		MaxConnsPerHost:     opts.maxConnsPerHost,
+		IdleConnTimeout:     opts.idleConnTimeout,
+	}
+
+	return &http.Client{Timeout: opts.conTimeout, Transport: tr}
+}
+
+// URL returns the iControl portal URL of the device.
+func (c *Client) URL() string {
+	if c.group != nil {
+		return c.group.url()
+	}
+	return c.url
+}
+
+// WithSession returns a copy of c sending every call in the iControl session id,
+// see the system/session package. The copy shares the connections of c.
+func (c *Client) WithSession(id int64) *Client {
+	cp := *c
+	cp.session = strconv.FormatInt(id, 10)
+	return &cp
+}
+
+// WithHTTPClient returns a copy of c sending every call through h, e.g. to record the calls
+// instead of sending them. The copy keeps the options of c, but calls the current endpoint only
+// and authenticates with the credentials of c rather than with a token.
+func (c *Client) WithHTTPClient(h HTTPClient) *Client {
+	cp := *c
+	cp.url = c.URL()
+	cp.client = h
+	cp.tokens = nil
+	cp.group = nil
+	return &cp
+}
+
+// CloseIdleConnections closes the idle keep-alive connections to the device.
+func (c *Client) CloseIdleConnections() {
+	if c.group != nil {
+		for _, m := range c.group.members {
+			m.CloseIdleConnections()
+		}
+		return
+	}
+	if cl, ok := c.client.(interface{ CloseIdleConnections() }); ok {
+		cl.CloseIdleConnections()
 	}
 }
 
 func (c *Client) Call(ctx context.Context, request interface{}) (response []byte, err error) {
 
+	if c.group != nil {
+		return c.group.call(ctx, c.session, request)
+	}
+
 	buffer := new(bytes.Buffer)
 
 	encoder := xml.NewEncoder(buffer)
@@ -119,16 +251,144 @@ func (c *Client) Call(ctx context.Context, request interface{}) (response []byte
 		return nil, err
 	}
 
+	body := buffer.Bytes()
+	op := parseOperation(body)
+
+	if c.opts.chunking != nil && op.idempotent() {
+		if chunks := splitEnvelope(body, c.opts.chunking.size(ctx)); len(chunks) > 1 {
+			return c.callChunks(ctx, op, chunks)
+		}
+	}
+
+	return c.send(ctx, op, body)
+}
+
+// send sends an encoded request envelope, retrying it following the retry policy of the client.
+func (c *Client) send(ctx context.Context, op operation, body []byte) (response []byte, err error) {
+
 	if c.opts.debug {
-		logit.Debug("==== request body ===>>\n", buffer.String())
+		c.opts.logger.DebugContext(ctx, "icontrol request", "namespace", op.Namespace, "operation", op.Name, "body", string(redact(body)))
 	}
 
-	req, err := http.NewRequest("POST", c.url, buffer)
+	attempts, tries := 1, 0
+	if c.opts.retry != nil && (op.idempotent() || isRetrySafe(ctx)) {
+		attempts = c.opts.retry.maxAttempts(ctx)
+	}
+
+	if len(c.opts.instrumentation) > 0 {
+		var end func(CallResult)
+		ctx, end = c.startCall(ctx, op, body)
+		start := time.Now()
+		defer func() {
+			end(newCallResult(tries, time.Since(start), err))
+		}()
+	}
+
+	for attempt := 1; ; attempt++ {
+		tries = attempt
+		response, err = c.attempt(ctx, op, body, attempt)
+		if err == nil || attempt >= attempts || !c.opts.retry.retryable(err) {
+			break
+		}
+		if err := c.opts.retry.wait(ctx, attempt); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.opts.debug {
+		c.opts.logger.DebugContext(ctx, "icontrol response", "namespace", op.Namespace, "operation", op.Name, "body", string(redact(response)))
+	}
+
+	return response, nil
+}
+
+// attempt sends the request once, logging in again and resending it once
+// if the device rejects the authentication token.
+func (c *Client) attempt(ctx context.Context, op operation, body []byte, n int) ([]byte, error) {
+
+	response, err := c.roundTrip(ctx, op, body, n)
+
+	var httpErr *HTTPError
+	if c.tokens != nil && errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusUnauthorized {
+		// The request sent again is a request like the others for the limiter and the hooks.
+		response, err = c.roundTrip(ctx, op, body, n)
+	}
+
+	return response, err
+}
+
+// roundTrip posts the request, waiting for the limiter and running the hooks of the client around it.
+func (c *Client) roundTrip(ctx context.Context, op operation, body []byte, n int) ([]byte, error) {
+
+	var queued time.Duration
+	if c.opts.limiter != nil {
+		var release func()
+		var err error
+		if queued, release, err = c.opts.limiter.wait(ctx, c.url); err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	if len(c.opts.hooks) == 0 {
+		return c.post(ctx, body)
+	}
+
+	info := CallInfo{
+		URL:         c.url,
+		Namespace:   op.Namespace,
+		Operation:   op.Name,
+		Attempt:     n,
+		RequestSize: len(body),
+		QueueTime:   queued,
+	}
+	for _, h := range c.opts.hooks {
+		if h.BeforeRequest != nil {
+			ctx = h.BeforeRequest(ctx, info)
+		}
+	}
+
+	start := time.Now()
+	response, err := c.post(ctx, body)
+	info.Duration = time.Since(start)
+	info.ResponseSize = len(response)
+	info.StatusCode = statusCode(err)
+
+	for _, h := range c.opts.hooks {
+		if err != nil && h.OnError != nil {
+			h.OnError(ctx, info, err)
+		} else if err == nil && h.AfterResponse != nil {
+			h.AfterResponse(ctx, info)
+		}
+	}
+
+	return response, err
+}
+
+// post sends one encoded request envelope to the device.
+func (c *Client) post(ctx context.Context, body []byte) ([]byte, error) {
+
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-	if c.opts.auth != nil {
-		req.SetBasicAuth(c.opts.auth.Login, c.opts.auth.Password)
+
+	var token string
+	switch {
+	case c.tokens != nil:
+		if token, err = c.tokens.Token(ctx); err != nil {
+			return nil, err
+		}
+		req.Header.Set(tokenHeader, token)
+	case c.opts.credentials != nil:
+		creds, err := c.opts.credentials.Credentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(creds.Username, creds.Password)
 	}
 
 	req = req.WithContext(ctx)
@@ -139,43 +399,31 @@ func (c *Client) Call(ctx context.Context, request interface{}) (response []byte
 			req.Header.Set(k, v)
 		}
 	}
-	req.Close = true
-
-	client := c.opts.client
-	if client == nil {
-		tr := &http.Transport{
-			TLSClientConfig: c.opts.tlsCfg,
-			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-				d := net.Dialer{Timeout: c.opts.timeout}
-				return d.DialContext(ctx, network, addr)
-			},
-			TLSHandshakeTimeout: c.opts.tlsHShakeTimeout,
-		}
-		client = &http.Client{Timeout: c.opts.conTimeout, Transport: tr}
+	if c.session != "" {
+		req.Header.Set(sessionHeader, c.session)
 	}
 
-	res, err := client.Do(req)
+	res, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode == http.StatusUnauthorized && token != "" {
+		c.tokens.invalidate(token)
+	}
+
 	if res.StatusCode >= 400 {
 		body, _ := ioutil.ReadAll(res.Body)
-		return nil, &HTTPError{
+		httpErr := &HTTPError{
 			StatusCode:   res.StatusCode,
 			ResponseBody: body,
 		}
+		if fault := parseFault(httpErr); fault != nil {
+			return nil, fault
+		}
+		return nil, httpErr
 	}
 
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if c.opts.debug {
-		logit.Debug("==== response body ===>>\n", string(body))
-	}
-
-	return body, nil
+	return ioutil.ReadAll(res.Body)
 }
@@ -0,0 +1,165 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+	"github.com/wule61/go-f5-soap/system/system_info"
+)
+
+// newBlockingServer returns a server that never answers and reports on the
+// returned channel when the in-flight request has been aborted by the client.
+func newBlockingServer(t *testing.T) (*httptest.Server, <-chan struct{}) {
+	aborted := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server only notices a client disconnect once the body is consumed.
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+			aborted <- struct{}{}
+		case <-release:
+		}
+	}))
+	t.Cleanup(func() {
+		close(release)
+		srv.Close()
+	})
+
+	return srv, aborted
+}
+
+func TestClient_CallCancel(t *testing.T) {
+
+	srv, aborted := newBlockingServer(t)
+	p := pool.New(soap.NewClient(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := p.GetListCtx(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight request was not aborted")
+	}
+}
+
+func TestClient_CallDeadline(t *testing.T) {
+
+	srv, aborted := newBlockingServer(t)
+	s := system_info.New(soap.NewClient(srv.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := s.GetVersionCtx(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("in-flight request was not aborted")
+	}
+}
+
+func TestClient_ReusesConnections(t *testing.T) {
+
+	var mu sync.Mutex
+	newConns := 0
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<Envelope><Body/></Envelope>`))
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mu.Lock()
+			newConns++
+			mu.Unlock()
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	c := soap.NewClient(srv.URL, soap.WithMaxIdleConnsPerHost(2), soap.WithIdleConnTimeout(time.Minute))
+	defer c.CloseIdleConnections()
+
+	for i := 0; i < 5; i++ {
+		if _, err := c.Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if newConns != 1 {
+		t.Fatalf("expected a single reused connection, got %d", newConns)
+	}
+}
+
+type countingHTTPClient struct {
+	calls int
+	next  *http.Client
+}
+
+func (c *countingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	return c.next.Do(req)
+}
+
+func TestClient_WithHTTPClient(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<Envelope><Body/></Envelope>`))
+	}))
+	defer srv.Close()
+
+	hc := &countingHTTPClient{next: srv.Client()}
+	c := soap.NewClient(srv.URL, soap.WithHTTPClient(hc))
+
+	if _, err := c.Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")); err != nil {
+		t.Fatal(err)
+	}
+
+	if hc.calls != 1 {
+		t.Fatalf("expected the custom HTTP client to be used once, got %d", hc.calls)
+	}
+}
+
+func TestClient_CopyWithHTTPClient(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Test") != "1" || r.Header.Get("X-iControl-Session") != "7" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = w.Write([]byte(`<Envelope><Body/></Envelope>`))
+	}))
+	defer srv.Close()
+
+	c := soap.NewClient(srv.URL, soap.WithHTTPHeaders(map[string]string{"X-Test": "1"})).WithSession(7)
+	hc := &countingHTTPClient{next: srv.Client()}
+
+	if _, err := c.WithHTTPClient(hc).Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")); err != nil {
+		t.Fatal(err)
+	}
+
+	if hc.calls != 1 {
+		t.Fatalf("expected the copy to use the custom HTTP client once, got %d", hc.calls)
+	}
+}
@@ -0,0 +1,176 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidAddress is returned for a malformed address or IP:port.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// Address is an IP address in a route domain, written 10.1.1.1%2 or 2001:db8::1%2 by the device.
+type Address struct {
+	Addr        netip.Addr `json:"addr" yaml:"addr"`
+	RouteDomain uint16     `json:"route_domain,omitempty" yaml:"route_domain,omitempty"` // The route domain ID, 0 for the default route domain.
+}
+
+// ParseAddress parses an address in F5 notation: an IPv4 or IPv6 address, optionally followed by %N
+// for route domain N. any and any6 stand for the wildcard addresses 0.0.0.0 and ::.
+func ParseAddress(s string) (Address, error) {
+
+	host, rd, hasRD := strings.Cut(s, "%")
+
+	var a Address
+	if hasRD {
+		id, err := strconv.ParseUint(rd, 10, 16)
+		if err != nil {
+			return Address{}, fmt.Errorf("%w %q: bad route domain", ErrInvalidAddress, s)
+		}
+		a.RouteDomain = uint16(id)
+	}
+
+	switch host {
+	case "any":
+		a.Addr = netip.IPv4Unspecified()
+	case "any6":
+		a.Addr = netip.IPv6Unspecified()
+	default:
+		addr, err := netip.ParseAddr(host)
+		if err != nil {
+			return Address{}, fmt.Errorf("%w %q: %v", ErrInvalidAddress, s, err)
+		}
+		a.Addr = addr
+	}
+
+	return a, nil
+}
+
+// String returns the address in F5 notation, the default route domain being omitted.
+func (a Address) String() string {
+	if a.RouteDomain == 0 {
+		return a.Addr.String()
+	}
+	return a.Addr.String() + "%" + strconv.FormatUint(uint64(a.RouteDomain), 10)
+}
+
+// IsWildcard reports whether a is 0.0.0.0 or ::.
+func (a Address) IsWildcard() bool {
+	return a.Addr.IsUnspecified()
+}
+
+// ParseIPPort parses an IP:port in F5 notation: 10.1.1.1:80 or 10.1.1.1%2:80 for IPv4,
+// 2001:db8::1.80 or 2001:db8::1%2.80 for IPv6, where the port is separated by a dot.
+// [2001:db8::1]:80 is accepted as well, and the port any stands for 0.
+func ParseIPPort(s string) (IPPortDefinition, error) {
+
+	var host, port string
+	switch {
+	case strings.HasPrefix(s, "["):
+		i := strings.LastIndex(s, "]:")
+		if i < 0 {
+			return IPPortDefinition{}, fmt.Errorf("%w %q: missing port", ErrInvalidAddress, s)
+		}
+		host, port = s[1:i], s[i+2:]
+	case strings.Count(s, ":") == 1:
+		host, port, _ = strings.Cut(s, ":")
+	case isIPv6(s):
+		i := strings.LastIndex(s, ".")
+		if i < 0 {
+			return IPPortDefinition{}, fmt.Errorf("%w %q: missing port", ErrInvalidAddress, s)
+		}
+		host, port = s[:i], s[i+1:]
+	default:
+		return IPPortDefinition{}, fmt.Errorf("%w %q: missing port", ErrInvalidAddress, s)
+	}
+
+	a, err := ParseAddress(host)
+	if err != nil {
+		return IPPortDefinition{}, err
+	}
+	p, err := parsePort(port)
+	if err != nil {
+		return IPPortDefinition{}, fmt.Errorf("%w %q: bad port", ErrInvalidAddress, s)
+	}
+
+	return IPPortDefinition{Address: a.String(), Port: int64(p)}, nil
+}
+
+func parsePort(s string) (uint16, error) {
+	if s == "any" || s == "*" {
+		return 0, nil
+	}
+	p, err := strconv.ParseUint(s, 10, 16)
+	return uint16(p), err
+}
+
+// IPPortFromAddrPort returns the IP:port of ap in route domain rd.
+func IPPortFromAddrPort(ap netip.AddrPort, rd uint16) IPPortDefinition {
+	a := Address{Addr: ap.Addr().WithZone(""), RouteDomain: rd}
+	return IPPortDefinition{Address: a.String(), Port: int64(ap.Port())}
+}
+
+// isIPv6 reports whether s starts with an IPv6 address, the port of which is separated by a dot.
+func isIPv6(s string) bool {
+	return strings.Contains(s, ":") || strings.HasPrefix(s, "any6")
+}
+
+// String returns d in F5 notation, e.g. 10.1.1.1%2:80, 2001:db8::1.80 or any6.80.
+func (d IPPortDefinition) String() string {
+	sep := ":"
+	if isIPv6(d.Address) {
+		sep = "."
+	}
+	return d.Address + sep + strconv.FormatInt(d.Port, 10)
+}
+
+// AddrPort returns the IP:port and the route domain of d.
+func (d IPPortDefinition) AddrPort() (netip.AddrPort, uint16, error) {
+	a, err := ParseAddress(d.Address)
+	if err != nil {
+		return netip.AddrPort{}, 0, err
+	}
+	if d.Port < 0 || d.Port > 65535 {
+		return netip.AddrPort{}, 0, fmt.Errorf("%w %q: port %d out of range", ErrInvalidAddress, d.String(), d.Port)
+	}
+	return netip.AddrPortFrom(a.Addr, uint16(d.Port)), a.RouteDomain, nil
+}
+
+// Validate checks that d has a valid address and port, e.g. before sending it to the device.
+func (d IPPortDefinition) Validate() error {
+	_, _, err := d.AddrPort()
+	return err
+}
+
+// Normalize returns d with its address in canonical form: IPv6 compressed and lower case,
+// any and any6 as wildcard addresses, and the default route domain %0 omitted.
+func (d IPPortDefinition) Normalize() (IPPortDefinition, error) {
+	ap, rd, err := d.AddrPort()
+	if err != nil {
+		return d, err
+	}
+	return IPPortFromAddrPort(ap, rd), nil
+}
+
+// Equal reports whether d and o are the same IP:port in the same route domain,
+// e.g. 2001:DB8:0::1 and 2001:db8::1. Invalid definitions are compared as written.
+func (d IPPortDefinition) Equal(o IPPortDefinition) bool {
+	nd, err1 := d.Normalize()
+	no, err2 := o.Normalize()
+	if err1 != nil || err2 != nil {
+		return d == o
+	}
+	return nd == no
+}
+
+// ValidateIPPorts validates defs, e.g. the members of a call, and reports the index of the first invalid one.
+func ValidateIPPorts(defs ...IPPortDefinition) error {
+	for i, d := range defs {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
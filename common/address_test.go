@@ -0,0 +1,100 @@
+package common
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestParseIPPort(t *testing.T) {
+
+	tests := []struct {
+		s    string
+		want IPPortDefinition
+	}{
+		{s: "10.1.1.1:80", want: IPPortDefinition{Address: "10.1.1.1", Port: 80}},
+		{s: "10.1.1.1%2:80", want: IPPortDefinition{Address: "10.1.1.1%2", Port: 80}},
+		{s: "10.1.1.1%0:80", want: IPPortDefinition{Address: "10.1.1.1", Port: 80}},
+		{s: "2001:DB8:0::1.443", want: IPPortDefinition{Address: "2001:db8::1", Port: 443}},
+		{s: "2001:db8::1%3.443", want: IPPortDefinition{Address: "2001:db8::1%3", Port: 443}},
+		{s: "[2001:db8::1]:443", want: IPPortDefinition{Address: "2001:db8::1", Port: 443}},
+		{s: "any:any", want: IPPortDefinition{Address: "0.0.0.0", Port: 0}},
+		{s: "any6.0", want: IPPortDefinition{Address: "::", Port: 0}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseIPPort(tt.s)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.s, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s: expected %v, got %v", tt.s, tt.want, got)
+		}
+	}
+
+	for _, s := range []string{"10.1.1.1", "10.1.1.1:65536", "10.1.1.1%x:80", "2001:db8::1", "host:80"} {
+		if _, err := ParseIPPort(s); !errors.Is(err, ErrInvalidAddress) {
+			t.Fatalf("%s: expected ErrInvalidAddress, got %v", s, err)
+		}
+	}
+}
+
+func TestIPPortDefinition(t *testing.T) {
+
+	d := IPPortDefinition{Address: "2001:db8::1%2", Port: 80}
+	if got := d.String(); got != "2001:db8::1%2.80" {
+		t.Fatalf("expected 2001:db8::1%%2.80, got %s", got)
+	}
+
+	ap, rd, err := d.AddrPort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ap != netip.MustParseAddrPort("[2001:db8::1]:80") || rd != 2 {
+		t.Fatalf("unexpected %v %d", ap, rd)
+	}
+	if got := IPPortFromAddrPort(ap, rd); got != d {
+		t.Fatalf("expected %v, got %v", d, got)
+	}
+
+	if !d.Equal(IPPortDefinition{Address: "2001:DB8:0:0::1%2", Port: 80}) {
+		t.Fatal("expected the definitions to be equal")
+	}
+	if d.Equal(IPPortDefinition{Address: "2001:db8::1", Port: 80}) {
+		t.Fatal("expected the route domains to differ")
+	}
+	if err := (IPPortDefinition{Address: "10.1.1.1", Port: 70000}).Validate(); !errors.Is(err, ErrInvalidAddress) {
+		t.Fatalf("expected ErrInvalidAddress, got %v", err)
+	}
+}
+
+func TestIPPortDefinition_RoundTrip(t *testing.T) {
+
+	tests := []struct {
+		d    IPPortDefinition
+		s    string
+		want IPPortDefinition
+	}{
+		{d: IPPortDefinition{Address: "10.1.1.1%2", Port: 80}, s: "10.1.1.1%2:80", want: IPPortDefinition{Address: "10.1.1.1%2", Port: 80}},
+		{d: IPPortDefinition{Address: "2001:db8::1", Port: 443}, s: "2001:db8::1.443", want: IPPortDefinition{Address: "2001:db8::1", Port: 443}},
+		{d: IPPortDefinition{Address: "any", Port: 80}, s: "any:80", want: IPPortDefinition{Address: "0.0.0.0", Port: 80}},
+		{d: IPPortDefinition{Address: "any6", Port: 80}, s: "any6.80", want: IPPortDefinition{Address: "::", Port: 80}},
+		{d: IPPortDefinition{Address: "any6%2", Port: 80}, s: "any6%2.80", want: IPPortDefinition{Address: "::%2", Port: 80}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.d.String(); got != tt.s {
+			t.Fatalf("expected %s, got %s", tt.s, got)
+		}
+		got, err := ParseIPPort(tt.s)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.s, err)
+		}
+		if !got.Equal(tt.d) || got != tt.want {
+			t.Fatalf("%s: expected %v, got %v", tt.s, tt.want, got)
+		}
+		if again, err := ParseIPPort(got.String()); err != nil || again != got {
+			t.Fatalf("%s: expected %v, got %v (%v)", got.String(), got, again, err)
+		}
+	}
+}
@@ -13,6 +13,55 @@ const (
 	StateEnabled EnabledState = "STATE_ENABLED"
 )
 
+// enabledStates are the values of EnabledState.
+var enabledStates = NewEnum("EnabledState", "STATE_", "BIG-IP_v9.0", StateDisabled, StateEnabled)
+
+// ParseEnabledState returns the EnabledState whose short or iControl form is s, e.g. enabled or STATE_ENABLED.
+func ParseEnabledState(s string) (EnabledState, error) {
+	return enabledStates.Parse(s)
+}
+
+// EnabledStateValues returns the values of EnabledState.
+func EnabledStateValues() []EnabledState {
+	return enabledStates.Values()
+}
+
+// IsValid reports whether e is one of the EnabledState values.
+func (e EnabledState) IsValid() bool {
+	return enabledStates.IsValid(e)
+}
+
+// String returns the iControl value of e.
+func (e EnabledState) String() string {
+	return string(e)
+}
+
+// Short returns the short form of e, e.g. enabled.
+func (e EnabledState) Short() string {
+	return enabledStates.Short(e)
+}
+
+// Introduced returns the BIG-IP version which introduced EnabledState.
+func (e EnabledState) Introduced() string {
+	return enabledStates.Introduced()
+}
+
+func (e EnabledState) MarshalJSON() ([]byte, error) {
+	return enabledStates.EncodeJSON(e)
+}
+
+func (e *EnabledState) UnmarshalJSON(b []byte) error {
+	return enabledStates.DecodeJSON(b, e)
+}
+
+func (e EnabledState) MarshalYAML() (interface{}, error) {
+	return enabledStates.EncodeYAML(e)
+}
+
+func (e *EnabledState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return enabledStates.DecodeYAML(unmarshal, e)
+}
+
 // AvailabilityStatus
 // Introduced : BIG-IP_v9.0
 // A list of possible values for an object&aposs availability status.
@@ -38,6 +87,62 @@ const (
 	AvailabilityStatusGray AvailabilityStatus = "AVAILABILITY_STATUS_GRAY"
 )
 
+// availabilityStatuses are the values of AvailabilityStatus.
+var availabilityStatuses = NewEnum("AvailabilityStatus", "AVAILABILITY_STATUS_", "BIG-IP_v9.0",
+	AvailabilityStatusNone,
+	AvailabilityStatusGreen,
+	AvailabilityStatusYellow,
+	AvailabilityStatusRed,
+	AvailabilityStatusBlue,
+	AvailabilityStatusGray,
+)
+
+// ParseAvailabilityStatus returns the AvailabilityStatus whose short or iControl form is s, e.g. yellow or AVAILABILITY_STATUS_YELLOW.
+func ParseAvailabilityStatus(s string) (AvailabilityStatus, error) {
+	return availabilityStatuses.Parse(s)
+}
+
+// AvailabilityStatusValues returns the values of AvailabilityStatus.
+func AvailabilityStatusValues() []AvailabilityStatus {
+	return availabilityStatuses.Values()
+}
+
+// IsValid reports whether a is one of the AvailabilityStatus values.
+func (a AvailabilityStatus) IsValid() bool {
+	return availabilityStatuses.IsValid(a)
+}
+
+// String returns the iControl value of a.
+func (a AvailabilityStatus) String() string {
+	return string(a)
+}
+
+// Short returns the short form of a, e.g. yellow.
+func (a AvailabilityStatus) Short() string {
+	return availabilityStatuses.Short(a)
+}
+
+// Introduced returns the BIG-IP version which introduced AvailabilityStatus.
+func (a AvailabilityStatus) Introduced() string {
+	return availabilityStatuses.Introduced()
+}
+
+func (a AvailabilityStatus) MarshalJSON() ([]byte, error) {
+	return availabilityStatuses.EncodeJSON(a)
+}
+
+func (a *AvailabilityStatus) UnmarshalJSON(b []byte) error {
+	return availabilityStatuses.DecodeJSON(b, a)
+}
+
+func (a AvailabilityStatus) MarshalYAML() (interface{}, error) {
+	return availabilityStatuses.EncodeYAML(a)
+}
+
+func (a *AvailabilityStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return availabilityStatuses.DecodeYAML(unmarshal, a)
+}
+
 // EnabledStatus
 // Introduced : BIG-IP_v9.0
 // A list of possible values for enabled status.
@@ -61,26 +166,80 @@ const (
 	EnabledStatusDisabledByParent EnabledStatus = "ENABLED_STATUS_DISABLED_BY_PARENT"
 )
 
+// enabledStatuses are the values of EnabledStatus.
+var enabledStatuses = NewEnum("EnabledStatus", "ENABLED_STATUS_", "BIG-IP_v9.0",
+	EnabledStatusNone,
+	EnabledStatusEnabled,
+	EnabledStatusDisabled,
+	EnabledStatusDisabledByParent,
+)
+
+// ParseEnabledStatus returns the EnabledStatus whose short or iControl form is s, e.g. disabled or ENABLED_STATUS_DISABLED.
+func ParseEnabledStatus(s string) (EnabledStatus, error) {
+	return enabledStatuses.Parse(s)
+}
+
+// EnabledStatusValues returns the values of EnabledStatus.
+func EnabledStatusValues() []EnabledStatus {
+	return enabledStatuses.Values()
+}
+
+// IsValid reports whether e is one of the EnabledStatus values.
+func (e EnabledStatus) IsValid() bool {
+	return enabledStatuses.IsValid(e)
+}
+
+// String returns the iControl value of e.
+func (e EnabledStatus) String() string {
+	return string(e)
+}
+
+// Short returns the short form of e, e.g. disabled.
+func (e EnabledStatus) Short() string {
+	return enabledStatuses.Short(e)
+}
+
+// Introduced returns the BIG-IP version which introduced EnabledStatus.
+func (e EnabledStatus) Introduced() string {
+	return enabledStatuses.Introduced()
+}
+
+func (e EnabledStatus) MarshalJSON() ([]byte, error) {
+	return enabledStatuses.EncodeJSON(e)
+}
+
+func (e *EnabledStatus) UnmarshalJSON(b []byte) error {
+	return enabledStatuses.DecodeJSON(b, e)
+}
+
+func (e EnabledStatus) MarshalYAML() (interface{}, error) {
+	return enabledStatuses.EncodeYAML(e)
+}
+
+func (e *EnabledStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return enabledStatuses.DecodeYAML(unmarshal, e)
+}
+
 // ObjectStatus
 // Introduced : BIG-IP_v9.0
 // An struct that specifies an object status.
 type ObjectStatus struct {
-	AvailabilityStatus AvailabilityStatus // The availability color status of the object.
-	EnabledStatus      EnabledStatus      // The enabled status of the object.
-	StatusDescription  string             // The textual description of the object’s status.
+	AvailabilityStatus AvailabilityStatus `json:"availability_status" yaml:"availability_status"` // The availability color status of the object.
+	EnabledStatus      EnabledStatus      `json:"enabled_status" yaml:"enabled_status"`           // The enabled status of the object.
+	StatusDescription  string             `json:"status_description" yaml:"status_description"`   // The textual description of the object’s status.
 }
 
 type IPPortDefinition struct {
-	Address string `xml:"address"`
-	Port    int64  `xml:"port"`
+	Address string `xml:"address" json:"address" yaml:"address"`
+	Port    int64  `xml:"port" json:"port" yaml:"port"`
 }
 
 type MemberRatio struct {
-	Member IPPortDefinition
-	Ratio  int64
+	Member IPPortDefinition `json:"member" yaml:"member"`
+	Ratio  int64            `json:"ratio" yaml:"ratio"`
 }
 
 type MemberObjectStatus struct {
-	Member IPPortDefinition
-	Status ObjectStatus
+	Member IPPortDefinition `json:"member" yaml:"member"`
+	Status ObjectStatus     `json:"status" yaml:"status"`
 }
@@ -0,0 +1,145 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidEnum is returned when parsing a value which does not belong to an enumeration.
+var ErrInvalidEnum = errors.New("invalid enum value")
+
+// OnUnknownEnum, when set, is called for each value decoded from JSON or YAML which is written
+// like the values of its enumeration but does not belong to it, e.g. a load balancing method of a
+// BIG-IP version newer than this package. Such values are kept as they are, and IsValid reports them.
+var OnUnknownEnum func(enum, value string)
+
+// Enum lists the values of an iControl enumeration, e.g. LB_METHOD_ROUND_ROBIN,
+// and maps them to their short form used by JSON and YAML: the value without the prefix
+// shared by the enumeration, in lower case with dashes, e.g. round-robin.
+//
+// The enumeration types use it to implement their methods:
+//
+//	var lbMethods = common.NewEnum("LBMethod", "LB_METHOD_", "BIG-IP_v9.2.0", LBMethodRoundRobin, ...)
+//
+//	func (m LBMethod) Short() string { return lbMethods.Short(m) }
+type Enum[T ~string] struct {
+	name       string
+	prefix     string
+	introduced string
+	values     []T
+}
+
+// NewEnum returns the enumeration name whose values share prefix, introduced by the given BIG-IP version.
+func NewEnum[T ~string](name, prefix, introduced string, values ...T) *Enum[T] {
+	return &Enum[T]{name: name, prefix: prefix, introduced: introduced, values: values}
+}
+
+// Values returns the values of the enumeration, in their declaration order.
+func (e *Enum[T]) Values() []T {
+	return append([]T(nil), e.values...)
+}
+
+// IsValid reports whether v belongs to the enumeration.
+func (e *Enum[T]) IsValid(v T) bool {
+	for _, known := range e.values {
+		if v == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Introduced returns the BIG-IP version which introduced the enumeration, e.g. BIG-IP_v9.2.0.
+func (e *Enum[T]) Introduced() string {
+	return e.introduced
+}
+
+// Short returns the short form of v, or v unchanged if it does not belong to the enumeration.
+func (e *Enum[T]) Short(v T) string {
+	if !e.IsValid(v) {
+		return string(v)
+	}
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(string(v), e.prefix)), "_", "-")
+}
+
+// Parse returns the value whose short or iControl form is s.
+// The empty string is the zero value, any other string must belong to the enumeration.
+func (e *Enum[T]) Parse(s string) (T, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, v := range e.values {
+		if s == string(v) || s == e.Short(v) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("%w %q for %s", ErrInvalidEnum, s, e.name)
+}
+
+// decode parses s like Parse, but keeps an unknown value written like the iControl values
+// of the enumeration, reporting it to OnUnknownEnum.
+func (e *Enum[T]) decode(s string) (T, error) {
+	v, err := e.Parse(s)
+	if err == nil || !e.looksLike(s) {
+		return v, err
+	}
+	if OnUnknownEnum != nil {
+		OnUnknownEnum(e.name, s)
+	}
+	return T(s), nil
+}
+
+// looksLike reports whether s is written like the iControl values of the enumeration, e.g. LB_METHOD_FOO.
+func (e *Enum[T]) looksLike(s string) bool {
+	if !strings.HasPrefix(s, e.prefix) || len(s) == len(e.prefix) {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// EncodeJSON encodes v in its short form.
+func (e *Enum[T]) EncodeJSON(v T) ([]byte, error) {
+	return json.Marshal(e.Short(v))
+}
+
+// DecodeJSON decodes a short or iControl form into v.
+func (e *Enum[T]) DecodeJSON(b []byte, v *T) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := e.decode(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// EncodeYAML encodes v in its short form.
+func (e *Enum[T]) EncodeYAML(v T) (interface{}, error) {
+	return e.Short(v), nil
+}
+
+// DecodeYAML decodes a short or iControl form into v.
+// unmarshal is the function given to the UnmarshalYAML method of the enumeration type.
+// This signature is supported by both gopkg.in/yaml.v2 and gopkg.in/yaml.v3, so that this package imports neither.
+func (e *Enum[T]) DecodeYAML(unmarshal func(interface{}) error, v *T) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	parsed, err := e.decode(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestEnum_JSON(t *testing.T) {
+
+	status := MemberObjectStatus{
+		Member: IPPortDefinition{Address: "10.1.1.1%2", Port: 80},
+		Status: ObjectStatus{
+			AvailabilityStatus: AvailabilityStatusGreen,
+			EnabledStatus:      EnabledStatusDisabledByParent,
+			StatusDescription:  "Available",
+		},
+	}
+
+	bt, err := json.Marshal(status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"member":{"address":"10.1.1.1%2","port":80},"status":{"availability_status":"green","enabled_status":"disabled-by-parent","status_description":"Available"}}`
+	if string(bt) != want {
+		t.Fatalf("expected %s, got %s", want, bt)
+	}
+
+	var got MemberObjectStatus
+	if err := json.Unmarshal(bt, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, status) {
+		t.Fatalf("expected %+v, got %+v", status, got)
+	}
+
+	// The iControl form is accepted as well, anything else is rejected.
+	var state EnabledState
+	if err := json.Unmarshal([]byte(`"STATE_DISABLED"`), &state); err != nil || state != StateDisabled {
+		t.Fatalf("expected %s, got %s (%v)", StateDisabled, state, err)
+	}
+	for _, s := range []string{`"Enabled"`, `"on"`, `1`} {
+		if err := json.Unmarshal([]byte(s), &state); err == nil {
+			t.Fatalf("%s: expected an error", s)
+		}
+	}
+	if err := json.Unmarshal([]byte(`"on"`), &state); !errors.Is(err, ErrInvalidEnum) {
+		t.Fatalf("expected ErrInvalidEnum, got %v", err)
+	}
+}
+
+func TestEnum_YAML(t *testing.T) {
+
+	ratios := []MemberRatio{{Member: IPPortDefinition{Address: "2001:db8::1", Port: 443}, Ratio: 3}}
+	status := ObjectStatus{AvailabilityStatus: AvailabilityStatusRed, EnabledStatus: EnabledStatusEnabled}
+
+	bt, err := yaml.Marshal(status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "availability_status: red\nenabled_status: enabled\nstatus_description: \"\"\n"
+	if string(bt) != want {
+		t.Fatalf("expected %q, got %q", want, bt)
+	}
+
+	var got ObjectStatus
+	if err := yaml.Unmarshal(bt, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != status {
+		t.Fatalf("expected %+v, got %+v", status, got)
+	}
+
+	bt, err = yaml.Marshal(ratios)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotRatios []MemberRatio
+	if err := yaml.Unmarshal(bt, &gotRatios); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotRatios, ratios) {
+		t.Fatalf("expected %+v, got %+v", ratios, gotRatios)
+	}
+
+	if err := yaml.Unmarshal([]byte("availability_status: purple\n"), &got); !errors.Is(err, ErrInvalidEnum) {
+		t.Fatalf("expected ErrInvalidEnum, got %v", err)
+	}
+}
+
+func TestEnum_Unknown(t *testing.T) {
+
+	var reported []string
+	OnUnknownEnum = func(enum, value string) { reported = append(reported, enum+" "+value) }
+	defer func() { OnUnknownEnum = nil }()
+
+	// A value of a newer BIG-IP version is kept and reported.
+	var status ObjectStatus
+	if err := json.Unmarshal([]byte(`{"availability_status":"AVAILABILITY_STATUS_PURPLE"}`), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.AvailabilityStatus != "AVAILABILITY_STATUS_PURPLE" || status.AvailabilityStatus.IsValid() {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if want := []string{"AvailabilityStatus AVAILABILITY_STATUS_PURPLE"}; !reflect.DeepEqual(reported, want) {
+		t.Fatalf("expected %v, got %v", want, reported)
+	}
+
+	bt, err := json.Marshal(status.AvailabilityStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bt) != `"AVAILABILITY_STATUS_PURPLE"` {
+		t.Fatalf("unexpected %s", bt)
+	}
+
+	// Parse is strict.
+	if _, err := ParseAvailabilityStatus("AVAILABILITY_STATUS_PURPLE"); !errors.Is(err, ErrInvalidEnum) {
+		t.Fatalf("expected ErrInvalidEnum, got %v", err)
+	}
+}
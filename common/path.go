@@ -0,0 +1,162 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ObjectPath is the full name of a configuration object: its partition, its folders and its name,
+// e.g. /Common/pool1 or /Common/app.app/pool1. A relative path, e.g. pool1 or app.app/pool1,
+// is resolved by the device against the active folder of the session.
+//
+// The APIs take and return names as strings, which Paths and PathStrings convert.
+type ObjectPath string
+
+// ErrInvalidPath is returned for a malformed object path.
+var ErrInvalidPath = errors.New("invalid object path")
+
+// iAppSuffix is the suffix of the folders created by an iApp application service.
+const iAppSuffix = ".app"
+
+// ParseObjectPath validates s as an object path.
+func ParseObjectPath(s string) (ObjectPath, error) {
+	p := ObjectPath(s)
+	return p, p.Validate()
+}
+
+// JoinObjectPath returns the absolute path of the object name in partition, under folders.
+func JoinObjectPath(partition string, elems ...string) ObjectPath {
+	return ObjectPath("/" + strings.Join(append([]string{partition}, elems...), "/"))
+}
+
+// Paths converts names, e.g. returned by the APIs, to object paths.
+func Paths(names ...string) []ObjectPath {
+	if names == nil {
+		return nil
+	}
+	paths := make([]ObjectPath, len(names))
+	for i, n := range names {
+		paths[i] = ObjectPath(n)
+	}
+	return paths
+}
+
+// PathStrings converts object paths to names, e.g. for the APIs taking names.
+func PathStrings(paths []ObjectPath) []string {
+	if paths == nil {
+		return nil
+	}
+	names := make([]string, len(paths))
+	for i, p := range paths {
+		names[i] = string(p)
+	}
+	return names
+}
+
+func (p ObjectPath) String() string {
+	return string(p)
+}
+
+// Validate checks that p is a non-empty path without empty elements, whitespace or control characters.
+func (p ObjectPath) Validate() error {
+
+	s := strings.TrimPrefix(string(p), "/")
+	if s == "" {
+		return fmt.Errorf("%w %q: empty", ErrInvalidPath, string(p))
+	}
+
+	for _, e := range strings.Split(s, "/") {
+		if e == "" || e == "." || e == ".." {
+			return fmt.Errorf("%w %q: empty or relative element", ErrInvalidPath, string(p))
+		}
+		for _, r := range e {
+			if unicode.IsSpace(r) || unicode.IsControl(r) {
+				return fmt.Errorf("%w %q: invalid character %q", ErrInvalidPath, string(p), r)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsAbsolute reports whether p starts with its partition.
+func (p ObjectPath) IsAbsolute() bool {
+	return strings.HasPrefix(string(p), "/")
+}
+
+// elems returns the elements of p.
+func (p ObjectPath) elems() []string {
+	s := strings.TrimPrefix(string(p), "/")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "/")
+}
+
+// Partition returns the partition of an absolute path, e.g. Common, or an empty string.
+func (p ObjectPath) Partition() string {
+	if !p.IsAbsolute() {
+		return ""
+	}
+	return p.elems()[0]
+}
+
+// Folders returns the folders between the partition and the name, e.g. [app.app] for /Common/app.app/pool1.
+// For a relative path, they are the folders before the name.
+func (p ObjectPath) Folders() []string {
+	elems := p.elems()
+	if p.IsAbsolute() && len(elems) > 0 {
+		elems = elems[1:]
+	}
+	if len(elems) < 2 {
+		return nil
+	}
+	return elems[:len(elems)-1]
+}
+
+// Folder returns the folder holding the object, e.g. /Common/app.app for /Common/app.app/pool1,
+// or an empty string for a name without folder.
+func (p ObjectPath) Folder() ObjectPath {
+	i := strings.LastIndex(string(p), "/")
+	if i <= 0 {
+		return ""
+	}
+	return p[:i]
+}
+
+// Name returns the name of the object without its folders, e.g. pool1 for /Common/app.app/pool1.
+// It is empty for a partition alone.
+func (p ObjectPath) Name() string {
+	elems := p.elems()
+	if len(elems) == 0 || (p.IsAbsolute() && len(elems) == 1) {
+		return ""
+	}
+	return elems[len(elems)-1]
+}
+
+// IApp returns the name of the iApp application service which created the object,
+// e.g. app for /Common/app.app/pool1, or an empty string.
+func (p ObjectPath) IApp() string {
+	for _, f := range p.Folders() {
+		if strings.HasSuffix(f, iAppSuffix) && len(f) > len(iAppSuffix) {
+			return strings.TrimSuffix(f, iAppSuffix)
+		}
+	}
+	return ""
+}
+
+// IsIApp reports whether the object was created by an iApp application service.
+func (p ObjectPath) IsIApp() bool {
+	return p.IApp() != ""
+}
+
+// Resolve returns the absolute path of p against the active folder, e.g. /Common.
+// An absolute p is returned unchanged.
+func (p ObjectPath) Resolve(active ObjectPath) ObjectPath {
+	if p.IsAbsolute() || p == "" {
+		return p
+	}
+	return ObjectPath(strings.TrimSuffix(string(active), "/") + "/" + string(p))
+}
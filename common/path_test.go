@@ -0,0 +1,72 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestObjectPath(t *testing.T) {
+
+	tests := []struct {
+		path      ObjectPath
+		partition string
+		folders   []string
+		folder    ObjectPath
+		name      string
+		iApp      string
+	}{
+		{path: "/Common/pool1", partition: "Common", folder: "/Common", name: "pool1"},
+		{path: "/Common/app.app/pool1", partition: "Common", folders: []string{"app.app"}, folder: "/Common/app.app", name: "pool1", iApp: "app"},
+		{path: "/Tenant/a/b/pool1", partition: "Tenant", folders: []string{"a", "b"}, folder: "/Tenant/a/b", name: "pool1"},
+		{path: "/Common", partition: "Common"},
+		{path: "pool1", name: "pool1"},
+		{path: "app.app/pool1", folders: []string{"app.app"}, folder: "app.app", name: "pool1", iApp: "app"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.path.Partition(); got != tt.partition {
+			t.Fatalf("%s: expected partition %q, got %q", tt.path, tt.partition, got)
+		}
+		if got := tt.path.Folders(); !reflect.DeepEqual(got, tt.folders) {
+			t.Fatalf("%s: expected folders %v, got %v", tt.path, tt.folders, got)
+		}
+		if got := tt.path.Folder(); got != tt.folder {
+			t.Fatalf("%s: expected folder %q, got %q", tt.path, tt.folder, got)
+		}
+		if got := tt.path.Name(); got != tt.name {
+			t.Fatalf("%s: expected name %q, got %q", tt.path, tt.name, got)
+		}
+		if got := tt.path.IApp(); got != tt.iApp {
+			t.Fatalf("%s: expected iApp %q, got %q", tt.path, tt.iApp, got)
+		}
+	}
+}
+
+func TestObjectPath_Validate(t *testing.T) {
+
+	for _, s := range []string{"/Common/pool1", "pool1", "/Common/app.app/pool1"} {
+		if _, err := ParseObjectPath(s); err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+	}
+
+	for _, s := range []string{"", "/", "/Common//pool1", "/Common/../pool1", "/Common/pool 1", "/Common/pool1/"} {
+		if _, err := ParseObjectPath(s); !errors.Is(err, ErrInvalidPath) {
+			t.Fatalf("%q: expected ErrInvalidPath, got %v", s, err)
+		}
+	}
+}
+
+func TestObjectPath_Resolve(t *testing.T) {
+
+	if got := JoinObjectPath("Common", "app.app", "pool1"); got != "/Common/app.app/pool1" {
+		t.Fatalf("expected /Common/app.app/pool1, got %s", got)
+	}
+	if got := ObjectPath("pool1").Resolve("/Common/"); got != "/Common/pool1" {
+		t.Fatalf("expected /Common/pool1, got %s", got)
+	}
+	if got := ObjectPath("/Tenant/pool1").Resolve("/Common"); got != "/Tenant/pool1" {
+		t.Fatalf("expected /Tenant/pool1, got %s", got)
+	}
+}
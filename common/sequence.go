@@ -0,0 +1,20 @@
+package common
+
+// Items is an iControl array, encoded as an item element for each value.
+type Items[T any] struct {
+	Item []T `xml:"item"`
+}
+
+// Sequences is an iControl array of arrays, e.g. a value for each member of each pool.
+type Sequences[T any] struct {
+	Item []Items[T] `xml:"item"`
+}
+
+// NewSequences returns values as an array of arrays.
+func NewSequences[T any](values [][]T) Sequences[T] {
+	var res Sequences[T]
+	for _, v := range values {
+		res.Item = append(res.Item, Items[T]{Item: v})
+	}
+	return res
+}
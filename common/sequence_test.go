@@ -0,0 +1,31 @@
+package common
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestSequences(t *testing.T) {
+
+	type req struct {
+		XMLName xml.Name         `xml:"req"`
+		Values  Sequences[int64] `xml:"values"`
+	}
+
+	bt, err := xml.Marshal(req{Values: NewSequences([][]int64{{1, 2}, {}})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `<req><values><item><item>1</item><item>2</item></item><item></item></values></req>`; string(bt) != want {
+		t.Fatalf("expected %s, got %s", want, bt)
+	}
+
+	var res req
+	if err := xml.Unmarshal(bt, &res); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewSequences([][]int64{{1, 2}, nil}); !reflect.DeepEqual(res.Values, want) {
+		t.Fatalf("expected %v, got %v", want, res.Values)
+	}
+}
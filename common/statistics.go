@@ -0,0 +1,96 @@
+package common
+
+import "time"
+
+// StatisticType
+// Introduced : BIG-IP_v9.0
+// The type of a statistic, e.g. STATISTIC_GTM_POOL_PREFERRED_LB_REQUESTS.
+// The device knows several hundred types; only those returned by the GTM interfaces of this module are declared.
+type StatisticType string
+
+const (
+	// StatisticGTMPoolPreferredLBRequests The number of requests load balanced with the preferred method of a pool.
+	StatisticGTMPoolPreferredLBRequests StatisticType = "STATISTIC_GTM_POOL_PREFERRED_LB_REQUESTS"
+
+	// StatisticGTMPoolAlternateLBRequests The number of requests load balanced with the alternate method of a pool.
+	StatisticGTMPoolAlternateLBRequests StatisticType = "STATISTIC_GTM_POOL_ALTERNATE_LB_REQUESTS"
+
+	// StatisticGTMPoolFallbackLBRequests The number of requests load balanced with the fallback method of a pool.
+	StatisticGTMPoolFallbackLBRequests StatisticType = "STATISTIC_GTM_POOL_FALLBACK_LB_REQUESTS"
+
+	// StatisticGTMPoolReturnedToDNS The number of requests of a pool returned to DNS.
+	StatisticGTMPoolReturnedToDNS StatisticType = "STATISTIC_GTM_POOL_RETURNED_TO_DNS"
+
+	// StatisticGTMPoolDroppedConnections The number of requests of a pool which were dropped.
+	StatisticGTMPoolDroppedConnections StatisticType = "STATISTIC_GTM_POOL_DROPPED_CONNECTIONS"
+
+	// StatisticServerSideCurrentConnections The current number of connections of a virtual server.
+	StatisticServerSideCurrentConnections StatisticType = "STATISTIC_SERVER_SIDE_CURRENT_CONNECTIONS"
+
+	// StatisticServerSideTotalConnections The total number of connections of a virtual server.
+	StatisticServerSideTotalConnections StatisticType = "STATISTIC_SERVER_SIDE_TOTAL_CONNECTIONS"
+
+	// StatisticServerSideBytesIn The number of bytes received by a virtual server.
+	StatisticServerSideBytesIn StatisticType = "STATISTIC_SERVER_SIDE_BYTES_IN"
+
+	// StatisticServerSideBytesOut The number of bytes sent by a virtual server.
+	StatisticServerSideBytesOut StatisticType = "STATISTIC_SERVER_SIDE_BYTES_OUT"
+)
+
+// ULong64
+// Introduced : BIG-IP_v9.0
+// A 64-bit unsigned value, sent by the device as its high and low 32 bits.
+type ULong64 struct {
+	High int64 `xml:"high" json:"high" yaml:"high"`
+	Low  int64 `xml:"low" json:"low" yaml:"low"`
+}
+
+// NewULong64 returns the ULong64 holding v.
+func NewULong64(v uint64) ULong64 {
+	return ULong64{High: int64(uint32(v >> 32)), Low: int64(uint32(v))}
+}
+
+// Value returns the value of u. The halves are read as unsigned 32-bit values,
+// the device sending the low half as a negative number when its high bit is set.
+func (u ULong64) Value() uint64 {
+	return uint64(uint32(u.High))<<32 | uint64(uint32(u.Low))
+}
+
+// TimeStamp
+// Introduced : BIG-IP_v9.0
+// The time of the device when statistics were gathered.
+type TimeStamp struct {
+	Year   int64 `xml:"year" json:"year" yaml:"year"`
+	Month  int64 `xml:"month" json:"month" yaml:"month"`
+	Day    int64 `xml:"day" json:"day" yaml:"day"`
+	Hour   int64 `xml:"hour" json:"hour" yaml:"hour"`
+	Minute int64 `xml:"minute" json:"minute" yaml:"minute"`
+	Second int64 `xml:"second" json:"second" yaml:"second"`
+}
+
+// Time returns the time stamp in loc, the time zone of the device.
+func (t TimeStamp) Time(loc *time.Location) time.Time {
+	return time.Date(int(t.Year), time.Month(t.Month), int(t.Day), int(t.Hour), int(t.Minute), int(t.Second), 0, loc)
+}
+
+// Statistic
+// Introduced : BIG-IP_v9.0
+// A statistic of an object.
+type Statistic struct {
+	Type      StatisticType `xml:"type" json:"type" yaml:"type"`
+	Value     ULong64       `xml:"value" json:"value" yaml:"value"`
+	TimeStamp int64         `xml:"time_stamp" json:"time_stamp" yaml:"time_stamp"`
+}
+
+// Statistics are the statistics of an object.
+type Statistics []Statistic
+
+// Value returns the value of the statistic of type t, and whether the object has it.
+func (s Statistics) Value(t StatisticType) (uint64, bool) {
+	for _, st := range s {
+		if st.Type == t {
+			return st.Value.Value(), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestULong64(t *testing.T) {
+
+	for _, v := range []uint64{0, 1, 1<<31 + 5, 1<<32 - 1, 1 << 32, 1<<63 + 1<<31, 1<<64 - 1} {
+		if got := NewULong64(v).Value(); got != v {
+			t.Fatalf("expected %d, got %d", v, got)
+		}
+	}
+
+	// The device sends each half as a signed 32-bit value.
+	var u ULong64
+	if err := xml.Unmarshal([]byte(`<value><high>1</high><low>-2147483648</low></value>`), &u); err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(1<<32 + 1<<31); u.Value() != want {
+		t.Fatalf("expected %d, got %d", want, u.Value())
+	}
+}
+
+func TestTimeStamp_Time(t *testing.T) {
+
+	ts := TimeStamp{Year: 2023, Month: 4, Day: 5, Hour: 6, Minute: 7, Second: 8}
+	if want := time.Date(2023, time.April, 5, 6, 7, 8, 0, time.UTC); !ts.Time(time.UTC).Equal(want) {
+		t.Fatalf("expected %v, got %v", want, ts.Time(time.UTC))
+	}
+}
+
+func TestStatistics_Value(t *testing.T) {
+
+	s := Statistics{
+		{Type: StatisticGTMPoolPreferredLBRequests, Value: NewULong64(12)},
+		{Type: StatisticGTMPoolReturnedToDNS, Value: NewULong64(3)},
+	}
+
+	if v, ok := s.Value(StatisticGTMPoolReturnedToDNS); !ok || v != 3 {
+		t.Fatalf("expected 3, got %d (%v)", v, ok)
+	}
+	if _, ok := s.Value(StatisticGTMPoolFallbackLBRequests); ok {
+		t.Fatal("expected a missing statistic")
+	}
+}
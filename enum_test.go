@@ -0,0 +1,137 @@
+package soap_test
+
+import (
+	"encoding/json"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/global_lb/monitor"
+	"github.com/wule61/go-f5-soap/management"
+	"github.com/wule61/go-f5-soap/system"
+)
+
+type enum interface {
+	~string
+	IsValid() bool
+	String() string
+	Short() string
+	Introduced() string
+}
+
+// declaredConstants returns the values of the constants of type typ declared in the package dir.
+func declaredConstants(t *testing.T, dir, typ string) map[string]bool {
+	t.Helper()
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consts := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					if id, ok := vs.Type.(*ast.Ident); !ok || id.Name != typ {
+						continue
+					}
+					for _, v := range vs.Values {
+						s, err := strconv.Unquote(v.(*ast.BasicLit).Value)
+						if err != nil {
+							t.Fatal(err)
+						}
+						consts[s] = true
+					}
+				}
+			}
+		}
+	}
+	return consts
+}
+
+// checkEnum checks that values are all the constants of typ declared in dir, and that each of them
+// parses back from its iControl and short forms and round-trips through JSON.
+func checkEnum[T enum](t *testing.T, dir, typ string, values []T, parse func(string) (T, error)) {
+	t.Helper()
+
+	consts := declaredConstants(t, dir, typ)
+	if len(values) != len(consts) {
+		t.Fatalf("%s: expected %d values, got %d", typ, len(consts), len(values))
+	}
+
+	shorts := make(map[string]bool)
+	for _, v := range values {
+		if !consts[string(v)] {
+			t.Fatalf("%s: %s is not a declared constant", typ, string(v))
+		}
+		if !v.IsValid() {
+			t.Fatalf("%s: %s is not valid", typ, string(v))
+		}
+		if !strings.HasPrefix(v.Introduced(), "BIG-IP_v") {
+			t.Fatalf("%s: unexpected version %q", typ, v.Introduced())
+		}
+
+		if v.String() != string(v) {
+			t.Fatalf("%s: expected %s, got %s", typ, string(v), v.String())
+		}
+		s := v.Short()
+		if s == "" || s != strings.ToLower(s) || shorts[s] {
+			t.Fatalf("%s: unexpected short form %q of %s", typ, s, string(v))
+		}
+		shorts[s] = true
+
+		for _, form := range []string{string(v), s} {
+			if got, err := parse(form); err != nil || got != v {
+				t.Fatalf("%s: expected %s for %q, got %s (%v)", typ, string(v), form, string(got), err)
+			}
+		}
+
+		bt, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got T
+		if err := json.Unmarshal(bt, &got); err != nil || got != v {
+			t.Fatalf("%s: expected %s from %s, got %s (%v)", typ, string(v), bt, string(got), err)
+		}
+	}
+
+	if _, err := parse("no such value"); !errors.Is(err, common.ErrInvalidEnum) {
+		t.Fatalf("%s: expected ErrInvalidEnum, got %v", typ, err)
+	}
+	if T("no such value").IsValid() {
+		t.Fatalf("%s: expected an unknown value not to be valid", typ)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	checkEnum(t, "common", "EnabledState", common.EnabledStateValues(), common.ParseEnabledState)
+	checkEnum(t, "common", "AvailabilityStatus", common.AvailabilityStatusValues(), common.ParseAvailabilityStatus)
+	checkEnum(t, "common", "EnabledStatus", common.EnabledStatusValues(), common.ParseEnabledStatus)
+	checkEnum(t, "system", "FailoverState", system.FailoverStateValues(), system.ParseFailoverState)
+	checkEnum(t, "management", "ZoneType", management.ZoneTypeValues(), management.ParseZoneType)
+	checkEnum(t, "global_lb", "RegionType", global_lb.RegionTypeValues(), global_lb.ParseRegionType)
+	checkEnum(t, "global_lb", "MonitorRuleType", global_lb.MonitorRuleTypeValues(), global_lb.ParseMonitorRuleType)
+	checkEnum(t, "global_lb", "RegionDBType", global_lb.RegionDBTypeValues(), global_lb.ParseRegionDBType)
+	checkEnum(t, "global_lb", "LBMethod", global_lb.LBMethodValues(), global_lb.ParseLBMethod)
+	checkEnum(t, "global_lb", "GTMQueryType", global_lb.GTMQueryTypeValues(), global_lb.ParseGTMQueryType)
+	checkEnum(t, "global_lb", "AddressType", global_lb.AddressTypeValues(), global_lb.ParseAddressType)
+	checkEnum(t, "global_lb", "MonitorInstanceStateType", global_lb.MonitorInstanceStateTypeValues(), global_lb.ParseMonitorInstanceStateType)
+	checkEnum(t, "global_lb", "MetricLimitType", global_lb.MetricLimitTypeValues(), global_lb.ParseMetricLimitType)
+	checkEnum(t, "global_lb/monitor", "TemplateType", monitor.TemplateTypeValues(), monitor.ParseTemplateType)
+	checkEnum(t, "global_lb/monitor", "IntPropertyType", monitor.IntPropertyTypeValues(), monitor.ParseIntPropertyType)
+	checkEnum(t, "global_lb/monitor", "StrPropertyType", monitor.StrPropertyTypeValues(), monitor.ParseStrPropertyType)
+}
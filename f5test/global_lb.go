@@ -0,0 +1,1470 @@
+package f5test
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/global_lb"
+)
+
+func init() {
+	register("urn:iControl:GlobalLB/Pool", poolHandlers)
+	register("urn:iControl:GlobalLB/PoolV2", poolV2Handlers)
+	register("urn:iControl:GlobalLB/PoolMember", poolMemberHandlers)
+	register("urn:iControl:GlobalLB/VirtualServer", virtualServerHandlers)
+	register("urn:iControl:GlobalLB/VirtualServerV2", virtualServerV2Handlers)
+	register("urn:iControl:GlobalLB/DataCenter", dataCenterHandlers)
+	register("urn:iControl:GlobalLB/WideIP", wideIPHandlers)
+	register("urn:iControl:GlobalLB/WideIPV2", wideIPV2Handlers)
+	register("urn:iControl:GlobalLB/Monitor", monitorHandlers)
+	register("urn:iControl:GlobalLB/ProberPool", proberPoolHandlers)
+	register("urn:iControl:GlobalLB/Region", regionHandlers)
+	register("urn:iControl:GlobalLB/Topology", topologyHandlers)
+}
+
+func objectStatus(s common.ObjectStatus) string {
+	return text("availability_status", s.AvailabilityStatus) +
+		text("enabled_status", s.EnabledStatus) +
+		text("status_description", s.StatusDescription)
+}
+
+func monitorRuleXML(r global_lb.MonitorRule) string {
+	return text("type", r.Type) +
+		text("quorum", r.Quorum) +
+		el("monitor_templates", values(r.MonitorTemplates))
+}
+
+func virtualServerID(n *node) global_lb.VirtualServerID {
+	return global_lb.VirtualServerID{Name: n.child("name").str(), Server: n.child("server").str()}
+}
+
+func poolID(n *node) global_lb.PoolID {
+	return global_lb.PoolID{PoolName: n.child("pool_name").str(), PoolType: global_lb.GTMQueryType(n.child("pool_type").str())}
+}
+
+func wideIPID(n *node) global_lb.WideIPID {
+	return global_lb.WideIPID{WideIPName: n.child("wideip_name").str(), WideIPType: global_lb.GTMQueryType(n.child("wideip_type").str())}
+}
+
+func memberName(pool string, m global_lb.VirtualServerID) string {
+	if m.Server == "" {
+		return pool + " " + m.Name
+	}
+	return pool + " " + m.Server + ":" + m.Name
+}
+
+// member returns the member of p identified by id.
+func (p *Pool) member(id global_lb.VirtualServerID) *PoolMember {
+	for i := range p.Members {
+		if p.Members[i].ID() == id {
+			return &p.Members[i]
+		}
+	}
+	return nil
+}
+
+// pools returns the A pools named by the array arg of the call.
+func (c *call) pools(arg string) ([]*Pool, error) {
+	var res []*Pool
+	for _, name := range c.args.child(arg).strings() {
+		p := c.m.pool(c.path(name), global_lb.GtmQueryTypeA)
+		if p == nil {
+			return nil, errNotFound("pool", c.path(name))
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// poolsOf returns the pools of the call, named by pool_names or identified by the PoolID array pools.
+func (c *call) poolsOf(arg string) ([]*Pool, error) {
+	if arg == "pools" {
+		return c.poolsByID(arg)
+	}
+	return c.pools(arg)
+}
+
+// poolsByID returns the pools identified by the PoolID array arg of the call.
+func (c *call) poolsByID(arg string) ([]*Pool, error) {
+	var res []*Pool
+	for _, it := range c.args.child(arg).items() {
+		id := poolID(it)
+		p := c.m.pool(c.path(id.PoolName), id.PoolType)
+		if p == nil {
+			return nil, errNotFound("pool", c.path(id.PoolName))
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// poolGetter answers an operation returning an attribute of each of the pools named by pool_names.
+func poolGetter(f func(p *Pool) string) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.pools("pool_names")
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(pools), func(i int) string { return f(pools[i]) })), nil
+	}
+}
+
+// poolV2Getter answers an operation returning an attribute of each of the pools identified by pools.
+func poolV2Getter(f func(p *Pool) string) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.poolsByID("pools")
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(pools), func(i int) string { return f(pools[i]) })), nil
+	}
+}
+
+// poolSetter answers an operation setting an attribute of each of the pools of poolsArg
+// to the item of the array arg at the same index.
+func poolSetter(poolsArg, arg string, f func(p *Pool, v *node) error) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.poolsOf(poolsArg)
+		if err != nil {
+			return "", err
+		}
+		vs := c.args.child(arg).items()
+		if len(vs) != len(pools) {
+			return "", errLength(poolsArg, arg)
+		}
+		for i, p := range pools {
+			if err := f(p, vs[i]); err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+	}
+}
+
+// poolMemberSetter answers an operation setting an attribute of each of the members of the pools of poolsArg
+// to the item of the array of arrays arg at the same indexes.
+func poolMemberSetter(poolsArg, arg string, f func(m *PoolMember, v *node) error) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.poolsOf(poolsArg)
+		if err != nil {
+			return "", err
+		}
+		members, vs := c.args.child("members").items(), c.args.child(arg).items()
+		if len(members) != len(pools) {
+			return "", errLength(poolsArg, "members")
+		}
+		if len(vs) != len(pools) {
+			return "", errLength(poolsArg, arg)
+		}
+		for i, p := range pools {
+			ids, values := members[i].items(), vs[i].items()
+			if len(values) != len(ids) {
+				return "", errLength("members", arg)
+			}
+			for j, it := range ids {
+				m := p.member(virtualServerID(it))
+				if m == nil {
+					return "", errNotFound("pool member", memberName(p.Name, virtualServerID(it)))
+				}
+				if err := f(m, values[j]); err != nil {
+					return "", err
+				}
+			}
+		}
+		return "", nil
+	}
+}
+
+// poolMembers returns the members of the pools named by pool_names identified by the array of arrays members.
+func (c *call) poolMembers() ([][]*PoolMember, error) {
+	pools, err := c.pools("pool_names")
+	if err != nil {
+		return nil, err
+	}
+	members := c.args.child("members").items()
+	if len(members) != len(pools) {
+		return nil, errLength("pool_names", "members")
+	}
+	var res [][]*PoolMember
+	for i, p := range pools {
+		var ms []*PoolMember
+		for _, it := range members[i].items() {
+			m := p.member(virtualServerID(it))
+			if m == nil {
+				return nil, errNotFound("pool member", memberName(p.Name, virtualServerID(it)))
+			}
+			ms = append(ms, m)
+		}
+		res = append(res, ms)
+	}
+	return res, nil
+}
+
+// poolMemberGetter answers an operation returning an attribute of each of the members of the pools named by pool_names.
+func poolMemberGetter(f func(m *PoolMember) string) handler {
+	return func(c *call) (string, error) {
+		members, err := c.poolMembers()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(members), func(i int) string {
+			return array(len(members[i]), func(j int) string { return f(members[i][j]) })
+		})), nil
+	}
+}
+
+func containsVirtualServer(ids []global_lb.VirtualServerID, id global_lb.VirtualServerID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// lbMethod returns the load balancing method held by n.
+func lbMethod(n *node) (global_lb.LBMethod, error) {
+	m := global_lb.LBMethod(n.str())
+	if !m.IsValid() {
+		return "", errInvalidArgument("Invalid load balancing method %s.", n.str())
+	}
+	return m, nil
+}
+
+// enabledState returns the enabled state held by n.
+func enabledState(n *node) (common.EnabledState, error) {
+	s := common.EnabledState(n.str())
+	if !s.IsValid() {
+		return "", errInvalidArgument("Invalid enabled state %s.", n.str())
+	}
+	return s, nil
+}
+
+// monitorRuleArg returns the monitor rule held by n, whose templates must exist.
+func (c *call) monitorRuleArg(n *node) (global_lb.MonitorRule, error) {
+	r := global_lb.MonitorRule{
+		Type:   global_lb.MonitorRuleType(n.child("type").str()),
+		Quorum: n.child("quorum").int(),
+	}
+	if !r.Type.IsValid() {
+		return r, errInvalidArgument("Invalid monitor rule type %s.", n.child("type").str())
+	}
+	for _, name := range n.child("monitor_templates").strings() {
+		if c.m.monitor(c.path(name)) == nil {
+			return r, errNotFound("monitor", c.path(name))
+		}
+		r.MonitorTemplates = append(r.MonitorTemplates, c.path(name))
+	}
+	return r, nil
+}
+
+// addPoolMembers adds to p the virtual servers of members in the given orders, which may be empty.
+func (c *call) addPoolMembers(p *Pool, members, orders []*node) error {
+	if len(orders) != 0 && len(orders) != len(members) {
+		return errLength("members", "orders")
+	}
+	for i, it := range members {
+		id := virtualServerID(it)
+		if c.m.virtualServer(id.Name, id.Server) == nil {
+			return errNotFound("virtual server", id.Server+":"+id.Name)
+		}
+		if p.member(id) != nil {
+			return errAlreadyExists("pool member", memberName(p.Name, id))
+		}
+		m := PoolMember{Name: id.Name, Server: id.Server, Ratio: 1, Enabled: common.StateEnabled}
+		if len(orders) != 0 {
+			m.Order = orders[i].int()
+		}
+		p.Members = append(p.Members, m)
+	}
+	return nil
+}
+
+// removePoolMembers removes the members of the array of arrays members from pools, identified by poolsArg.
+func (c *call) removePoolMembers(poolsArg string, pools []*Pool) error {
+	members := c.args.child("members").items()
+	if len(members) != len(pools) {
+		return errLength(poolsArg, "members")
+	}
+	for i, p := range pools {
+		for _, it := range members[i].items() {
+			id := virtualServerID(it)
+			if p.member(id) == nil {
+				return errNotFound("pool member", memberName(p.Name, id))
+			}
+			ms := p.Members[:0]
+			for _, m := range p.Members {
+				if m.ID() != id {
+					ms = append(ms, m)
+				}
+			}
+			p.Members = ms
+		}
+	}
+	return nil
+}
+
+// poolIDsOf returns the identifiers of the pools of the call, the A pools named by pool_names
+// or the typed pools of the PoolID array pools.
+func (c *call) poolIDsOf(arg string) []global_lb.PoolID {
+	var res []global_lb.PoolID
+	if arg == "pools" {
+		for _, it := range c.args.child(arg).items() {
+			res = append(res, poolID(it))
+		}
+		return res
+	}
+	for _, name := range c.args.child(arg).strings() {
+		res = append(res, global_lb.PoolID{PoolName: name, PoolType: global_lb.GtmQueryTypeA})
+	}
+	return res
+}
+
+// createPools creates the pools of poolsArg with the methods of lb_methods, adding their members with add.
+func (c *call) createPools(poolsArg string, add func(p *Pool, i int) error) (string, error) {
+	ids := c.poolIDsOf(poolsArg)
+	methods := c.args.child("lb_methods").items()
+	if len(methods) != len(ids) {
+		return "", errLength(poolsArg, "lb_methods")
+	}
+	if len(c.args.child("members").items()) != len(ids) {
+		return "", errLength(poolsArg, "members")
+	}
+	for i, id := range ids {
+		name, t := c.path(id.PoolName), queryType(id.PoolType)
+		if !t.IsValid() || t == global_lb.GtmQueryTypeUnknown {
+			return "", errInvalidArgument("Invalid pool type %s.", t)
+		}
+		if c.m.pool(name, t) != nil {
+			return "", errAlreadyExists("pool", name)
+		}
+		method, err := lbMethod(methods[i])
+		if err != nil {
+			return "", err
+		}
+		c.m.pools = append(c.m.pools, normalizePool(Pool{Name: name, Type: t, PreferredLBMethod: method}))
+		if err := add(&c.m.pools[len(c.m.pools)-1], i); err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// deletePool answers an operation deleting the pools of poolsArg.
+func deletePool(poolsArg string) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.poolsOf(poolsArg)
+		if err != nil {
+			return "", err
+		}
+		c.m.deletePools(func(p *Pool) bool {
+			for _, del := range pools {
+				if p.Name == del.Name && p.Type == del.Type {
+					return true
+				}
+			}
+			return false
+		})
+		return "", nil
+	}
+}
+
+// setMonitorAssociations sets the monitor rules of the items of monitor_associations, their pool identified by id.
+func (c *call) setMonitorAssociations(id func(it *node) global_lb.PoolID) error {
+	for _, it := range c.args.child("monitor_associations").items() {
+		pid := id(it)
+		name := c.path(pid.PoolName)
+		p := c.m.pool(name, pid.PoolType)
+		if p == nil {
+			return errNotFound("pool", name)
+		}
+		r, err := c.monitorRuleArg(it.child("monitor_rule"))
+		if err != nil {
+			return err
+		}
+		p.Monitor = r
+	}
+	return nil
+}
+
+// removeMonitorAssociation answers an operation resetting the monitor rules of the pools of poolsArg.
+func removeMonitorAssociation(poolsArg string) handler {
+	return func(c *call) (string, error) {
+		pools, err := c.poolsOf(poolsArg)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range pools {
+			p.Monitor = monitorRule(global_lb.MonitorRule{})
+		}
+		return "", nil
+	}
+}
+
+// The setters of the pool attributes shared by the Pool and PoolV2 interfaces.
+
+func setPreferredLBMethod(p *Pool, v *node) (err error) {
+	p.PreferredLBMethod, err = lbMethod(v)
+	return err
+}
+
+func setAlternateLBMethod(p *Pool, v *node) (err error) {
+	p.AlternateLBMethod, err = lbMethod(v)
+	return err
+}
+
+func setFallbackLBMethod(p *Pool, v *node) (err error) {
+	p.FallbackLBMethod, err = lbMethod(v)
+	return err
+}
+
+func setTTL(p *Pool, v *node) error {
+	p.TTL = v.int()
+	return nil
+}
+
+func setAnswersToReturn(p *Pool, v *node) error {
+	p.AnswersToReturn = v.int()
+	return nil
+}
+
+func setVerifyMemberAvailability(p *Pool, v *node) (err error) {
+	p.VerifyMemberAvailability, err = enabledState(v)
+	return err
+}
+
+func setEnabledState(p *Pool, v *node) (err error) {
+	p.Enabled, err = enabledState(v)
+	p.Status = common.ObjectStatus{}
+	return err
+}
+
+func setFallbackIP(p *Pool, v *node) error {
+	p.FallbackIP = v.str()
+	return nil
+}
+
+// deletePools removes the pools for which del returns true.
+func (m *model) deletePools(del func(p *Pool) bool) {
+	pools := m.pools[:0]
+	for i := range m.pools {
+		if !del(&m.pools[i]) {
+			pools = append(pools, m.pools[i])
+		}
+	}
+	m.pools = pools
+}
+
+// poolStatisticTypes are the statistics returned for each pool.
+var poolStatisticTypes = []common.StatisticType{
+	common.StatisticGTMPoolPreferredLBRequests,
+	common.StatisticGTMPoolAlternateLBRequests,
+	common.StatisticGTMPoolFallbackLBRequests,
+	common.StatisticGTMPoolReturnedToDNS,
+	common.StatisticGTMPoolDroppedConnections,
+}
+
+// poolStatistics returns the statistics of p, zero unless set.
+func poolStatistics(p *Pool) common.Statistics {
+	var res common.Statistics
+	for _, t := range poolStatisticTypes {
+		res = append(res, common.Statistic{Type: t, Value: common.NewULong64(p.Statistics[t])})
+	}
+	return res
+}
+
+// poolMemberStatisticTypes are the statistics returned for each pool member.
+var poolMemberStatisticTypes = []common.StatisticType{
+	common.StatisticServerSideCurrentConnections,
+	common.StatisticServerSideTotalConnections,
+	common.StatisticServerSideBytesIn,
+	common.StatisticServerSideBytesOut,
+}
+
+// poolMemberStatistics returns the statistics of m, zero unless set.
+func poolMemberStatistics(m *PoolMember) common.Statistics {
+	var res common.Statistics
+	for _, t := range poolMemberStatisticTypes {
+		res = append(res, common.Statistic{Type: t, Value: common.NewULong64(m.Statistics[t])})
+	}
+	return res
+}
+
+// timeStamp renders the fields of the time stamp of t.
+func timeStamp(t time.Time) string {
+	return fields(common.TimeStamp{
+		Year:   int64(t.Year()),
+		Month:  int64(t.Month()),
+		Day:    int64(t.Day()),
+		Hour:   int64(t.Hour()),
+		Minute: int64(t.Minute()),
+		Second: int64(t.Second()),
+	})
+}
+
+var poolHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var names []string
+		for _, p := range c.m.pools {
+			if p.Type == global_lb.GtmQueryTypeA && c.listed(p.Name) {
+				names = append(names, p.Name)
+			}
+		}
+		return ret(values(names)), nil
+	},
+	"get_member_v2": poolGetter(func(p *Pool) string {
+		return array(len(p.Members), func(i int) string {
+			return text("name", p.Members[i].Name) + text("server", p.Members[i].Server)
+		})
+	}),
+	"get_member_ratio": poolMemberGetter(func(m *PoolMember) string {
+		return esc(m.Ratio)
+	}),
+	"get_member_order": poolMemberGetter(func(m *PoolMember) string {
+		return esc(m.Order)
+	}),
+	"get_member_enabled_state": poolMemberGetter(func(m *PoolMember) string {
+		return esc(m.Enabled)
+	}),
+	"get_member_limit": poolMemberGetter(func(m *PoolMember) string {
+		return values(m.Limits)
+	}),
+	"get_member_monitor_rule": poolMemberGetter(func(m *PoolMember) string {
+		return monitorRuleXML(monitorRule(m.Monitor))
+	}),
+	"get_member_dependency": poolMemberGetter(func(m *PoolMember) string {
+		return values(m.Dependencies)
+	}),
+	"get_member_description": poolMemberGetter(func(m *PoolMember) string {
+		return esc(m.Description)
+	}),
+	"get_member_statistics": func(c *call) (string, error) {
+		members, err := c.poolMembers()
+		if err != nil {
+			return "", err
+		}
+		now := time.Now()
+		return ret(array(len(members), func(i int) string {
+			return el("statistics", array(len(members[i]), func(j int) string {
+				m := members[i][j]
+				return el("member", fields(m.ID())) + el("statistics", values(poolMemberStatistics(m)))
+			})) + el("time_stamp", timeStamp(now))
+		})), nil
+	},
+	"get_monitor_association": poolGetter(func(p *Pool) string {
+		return text("pool_name", p.Name) + el("monitor_rule", monitorRuleXML(p.Monitor))
+	}),
+	"get_alternate_lb_method": poolGetter(func(p *Pool) string {
+		return esc(p.AlternateLBMethod)
+	}),
+	"get_preferred_lb_method": poolGetter(func(p *Pool) string {
+		return esc(p.PreferredLBMethod)
+	}),
+	"get_ttl": poolGetter(func(p *Pool) string {
+		return esc(p.TTL)
+	}),
+	"get_verify_member_availability_state": poolGetter(func(p *Pool) string {
+		return esc(p.VerifyMemberAvailability)
+	}),
+	"get_answers_to_return": poolGetter(func(p *Pool) string {
+		return esc(p.AnswersToReturn)
+	}),
+	"get_object_status": poolGetter(func(p *Pool) string {
+		return objectStatus(status(p.Status, p.Enabled))
+	}),
+	"get_enabled_state": poolGetter(func(p *Pool) string {
+		return esc(p.Enabled)
+	}),
+	"get_fallback_lb_method": poolGetter(func(p *Pool) string {
+		return esc(p.FallbackLBMethod)
+	}),
+	"get_fallback_ip": poolGetter(func(p *Pool) string {
+		return esc(p.FallbackIP)
+	}),
+	"get_limit": poolGetter(func(p *Pool) string {
+		return values(p.Limits)
+	}),
+	"get_qos_coefficients": poolGetter(func(p *Pool) string {
+		return fields(p.QoS)
+	}),
+	"get_manual_resume_state": poolGetter(func(p *Pool) string {
+		return esc(p.ManualResume)
+	}),
+	"get_dynamic_ratio_state": poolGetter(func(p *Pool) string {
+		return esc(p.DynamicRatio)
+	}),
+	"get_cname": poolGetter(func(p *Pool) string {
+		return esc(p.CNAME)
+	}),
+	"get_description": poolGetter(func(p *Pool) string {
+		return esc(p.Description)
+	}),
+	"get_all_statistics": func(c *call) (string, error) {
+		var pools []*Pool
+		for i := range c.m.pools {
+			if c.m.pools[i].Type == global_lb.GtmQueryTypeA && c.listed(c.m.pools[i].Name) {
+				pools = append(pools, &c.m.pools[i])
+			}
+		}
+		return ret(el("statistics", array(len(pools), func(i int) string {
+			return text("pool_name", pools[i].Name) + el("statistics", values(poolStatistics(pools[i])))
+		})) + el("time_stamp", timeStamp(time.Now()))), nil
+	},
+	"create": func(c *call) (string, error) {
+		members := c.args.child("members").items()
+		return c.createPools("pool_names", func(p *Pool, i int) error {
+			for _, it := range members[i].items() {
+				addr := common.IPPortDefinition{Address: it.child("member").child("address").str(), Port: it.child("member").child("port").int()}
+				var found *VirtualServer
+				for j := range c.m.virtualServers {
+					if c.m.virtualServers[j].Destination.Equal(addr) {
+						found = &c.m.virtualServers[j]
+						break
+					}
+				}
+				if found == nil {
+					return errNotFound("virtual server", addr.String())
+				}
+				id := global_lb.VirtualServerID{Name: found.Name, Server: found.Server}
+				if p.member(id) != nil {
+					return errAlreadyExists("pool member", memberName(p.Name, id))
+				}
+				p.Members = append(p.Members, PoolMember{Name: found.Name, Server: found.Server, Ratio: 1, Order: it.child("order").int(), Enabled: common.StateEnabled})
+			}
+			return nil
+		})
+	},
+	"create_v2": func(c *call) (string, error) {
+		members, orders := c.args.child("members").items(), c.args.child("orders").items()
+		if len(orders) != len(members) {
+			return "", errLength("members", "orders")
+		}
+		return c.createPools("pool_names", func(p *Pool, i int) error {
+			return c.addPoolMembers(p, members[i].items(), orders[i].items())
+		})
+	},
+	"delete_all_pools": func(c *call) (string, error) {
+		c.m.deletePools(func(p *Pool) bool { return p.Type == global_lb.GtmQueryTypeA })
+		return "", nil
+	},
+	"delete_pool": deletePool("pool_names"),
+	"add_member_v2": func(c *call) (string, error) {
+		pools, err := c.pools("pool_names")
+		if err != nil {
+			return "", err
+		}
+		members, orders := c.args.child("members").items(), c.args.child("orders").items()
+		if len(members) != len(pools) {
+			return "", errLength("pool_names", "members")
+		}
+		if len(orders) != len(pools) {
+			return "", errLength("pool_names", "orders")
+		}
+		for i, p := range pools {
+			if err := c.addPoolMembers(p, members[i].items(), orders[i].items()); err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+	},
+	"remove_member_v2": func(c *call) (string, error) {
+		pools, err := c.pools("pool_names")
+		if err != nil {
+			return "", err
+		}
+		return "", c.removePoolMembers("pool_names", pools)
+	},
+	"set_preferred_lb_method":              poolSetter("pool_names", "lb_methods", setPreferredLBMethod),
+	"set_alternate_lb_method":              poolSetter("pool_names", "lb_methods", setAlternateLBMethod),
+	"set_fallback_lb_method":               poolSetter("pool_names", "lb_methods", setFallbackLBMethod),
+	"set_ttl":                              poolSetter("pool_names", "values", setTTL),
+	"set_answers_to_return":                poolSetter("pool_names", "answers", setAnswersToReturn),
+	"set_verify_member_availability_state": poolSetter("pool_names", "states", setVerifyMemberAvailability),
+	"set_enabled_state":                    poolSetter("pool_names", "states", setEnabledState),
+	"set_member_ratio": poolMemberSetter("pool_names", "ratios", func(m *PoolMember, v *node) error {
+		m.Ratio = v.int()
+		return nil
+	}),
+	"set_member_order": poolMemberSetter("pool_names", "orders", func(m *PoolMember, v *node) error {
+		m.Order = v.int()
+		return nil
+	}),
+	"set_member_enabled_state": poolMemberSetter("pool_names", "states", func(m *PoolMember, v *node) (err error) {
+		m.Enabled, err = enabledState(v)
+		m.Status = common.ObjectStatus{}
+		return err
+	}),
+	"set_member_limit": poolMemberSetter("pool_names", "limits", func(m *PoolMember, v *node) error {
+		// Like the device, only the metrics sent are changed and a zero value removes the limit.
+		for _, it := range v.items() {
+			l := global_lb.MetricLimit{MetricType: global_lb.MetricLimitType(it.child("metric_type").str()), Value: it.child("value").int()}
+			if !l.MetricType.IsValid() {
+				return errInvalidArgument("Invalid metric limit type %s.", l.MetricType)
+			}
+			limits := m.Limits[:0:0]
+			for _, cur := range m.Limits {
+				if cur.MetricType != l.MetricType {
+					limits = append(limits, cur)
+				}
+			}
+			if l.Value != 0 {
+				limits = append(limits, l)
+			}
+			m.Limits = limits
+		}
+		return nil
+	}),
+	"set_member_monitor_rule": func(c *call) (string, error) {
+		return poolMemberSetter("pool_names", "monitor_rules", func(m *PoolMember, v *node) (err error) {
+			m.Monitor, err = c.monitorRuleArg(v)
+			return err
+		})(c)
+	},
+	"add_member_dependency": func(c *call) (string, error) {
+		return poolMemberSetter("pool_names", "dependencies", func(m *PoolMember, v *node) error {
+			for _, it := range v.items() {
+				id := virtualServerID(it)
+				if c.m.virtualServer(id.Name, id.Server) == nil {
+					return errNotFound("virtual server", id.Server+":"+id.Name)
+				}
+				if id == m.ID() {
+					return errInvalidArgument("The pool member %s cannot depend on itself.", id.Server+":"+id.Name)
+				}
+				if !containsVirtualServer(m.Dependencies, id) {
+					m.Dependencies = append(m.Dependencies, id)
+				}
+			}
+			return nil
+		})(c)
+	},
+	"remove_member_dependency": poolMemberSetter("pool_names", "dependencies", func(m *PoolMember, v *node) error {
+		for _, it := range v.items() {
+			id := virtualServerID(it)
+			if !containsVirtualServer(m.Dependencies, id) {
+				return errNotFound("dependency", id.Server+":"+id.Name)
+			}
+			deps := m.Dependencies[:0]
+			for _, d := range m.Dependencies {
+				if d != id {
+					deps = append(deps, d)
+				}
+			}
+			m.Dependencies = deps
+		}
+		return nil
+	}),
+	"remove_all_member_dependencies": func(c *call) (string, error) {
+		members, err := c.poolMembers()
+		if err != nil {
+			return "", err
+		}
+		for _, ms := range members {
+			for _, m := range ms {
+				m.Dependencies = nil
+			}
+		}
+		return "", nil
+	},
+	"set_member_description": poolMemberSetter("pool_names", "descriptions", func(m *PoolMember, v *node) error {
+		m.Description = v.str()
+		return nil
+	}),
+	"set_monitor_association": func(c *call) (string, error) {
+		return "", c.setMonitorAssociations(func(it *node) global_lb.PoolID {
+			return global_lb.PoolID{PoolName: it.child("pool_name").str(), PoolType: global_lb.GtmQueryTypeA}
+		})
+	},
+	"remove_monitor_association": removeMonitorAssociation("pool_names"),
+}
+
+var poolV2Handlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var ids []global_lb.PoolID
+		for _, p := range c.m.pools {
+			if c.listed(p.Name) {
+				ids = append(ids, p.ID())
+			}
+		}
+		return ret(values(ids)), nil
+	},
+	"get_list_by_type": func(c *call) (string, error) {
+		types := c.args.child("types").strings()
+		return ret(array(len(types), func(i int) string {
+			var ids []global_lb.PoolID
+			for _, p := range c.m.pools {
+				if string(p.Type) == types[i] && c.listed(p.Name) {
+					ids = append(ids, p.ID())
+				}
+			}
+			return values(ids)
+		})), nil
+	},
+	"get_member": poolV2Getter(func(p *Pool) string {
+		return array(len(p.Members), func(i int) string {
+			m := p.Members[i]
+			return text("name", m.Name) + text("server", m.Server) +
+				text("order", m.Order) + text("ratio", m.Ratio) + nonTerminalXML(p.Type, m)
+		})
+	}),
+	"add_member": func(c *call) (string, error) {
+		pools, err := c.poolsByID("pools")
+		if err != nil {
+			return "", err
+		}
+		members := c.args.child("members").items()
+		if len(members) != len(pools) {
+			return "", errLength("pools", "members")
+		}
+		for i, p := range pools {
+			for _, it := range members[i].items() {
+				if err := c.addPoolV2Member(p, it); err != nil {
+					return "", err
+				}
+			}
+		}
+		return "", nil
+	},
+	"remove_member": func(c *call) (string, error) {
+		pools, err := c.poolsByID("pools")
+		if err != nil {
+			return "", err
+		}
+		return "", c.removePoolMembers("pools", pools)
+	},
+	"get_preferred_lb_method": poolV2Getter(func(p *Pool) string {
+		return esc(p.PreferredLBMethod)
+	}),
+	"get_alternate_lb_method": poolV2Getter(func(p *Pool) string {
+		return esc(p.AlternateLBMethod)
+	}),
+	"get_fallback_lb_method": poolV2Getter(func(p *Pool) string {
+		return esc(p.FallbackLBMethod)
+	}),
+	"get_monitor_association": poolV2Getter(func(p *Pool) string {
+		return el("pool", fields(p.ID())) + el("monitor_rule", monitorRuleXML(p.Monitor))
+	}),
+	"create": func(c *call) (string, error) {
+		members := c.args.child("members").items()
+		return c.createPools("pools", func(p *Pool, i int) error {
+			for _, it := range members[i].items() {
+				if err := c.addPoolV2Member(p, it); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	},
+	"delete_all_pools": func(c *call) (string, error) {
+		c.m.deletePools(func(p *Pool) bool { return true })
+		return "", nil
+	},
+	"delete_pool":                deletePool("pools"),
+	"set_preferred_lb_method":    poolSetter("pools", "lb_methods", setPreferredLBMethod),
+	"set_alternate_lb_method":    poolSetter("pools", "lb_methods", setAlternateLBMethod),
+	"set_fallback_lb_method":     poolSetter("pools", "lb_methods", setFallbackLBMethod),
+	"set_ttl":                    poolSetter("pools", "values", setTTL),
+	"set_answers_to_return":      poolSetter("pools", "answers", setAnswersToReturn),
+	"set_fallback_ip":            poolSetter("pools", "ips", setFallbackIP),
+	"set_enabled_state":          poolSetter("pools", "states", setEnabledState),
+	"remove_monitor_association": removeMonitorAssociation("pools"),
+	"set_monitor_association": func(c *call) (string, error) {
+		return "", c.setMonitorAssociations(func(it *node) global_lb.PoolID {
+			return poolID(it.child("pool"))
+		})
+	},
+	"set_member_order": poolMemberSetter("pools", "orders", func(m *PoolMember, v *node) error {
+		m.Order = v.int()
+		return nil
+	}),
+	"set_member_ratio": poolMemberSetter("pools", "ratios", func(m *PoolMember, v *node) error {
+		m.Ratio = v.int()
+		return nil
+	}),
+	"get_ttl": poolV2Getter(func(p *Pool) string {
+		return esc(p.TTL)
+	}),
+	"get_enabled_state": poolV2Getter(func(p *Pool) string {
+		return esc(p.Enabled)
+	}),
+	"get_object_status": poolV2Getter(func(p *Pool) string {
+		return objectStatus(status(p.Status, p.Enabled))
+	}),
+}
+
+// nonTerminalXML renders the settings of the non-terminal member m applying to pools of type t.
+func nonTerminalXML(t global_lb.GTMQueryType, m PoolMember) string {
+	switch t {
+	case global_lb.GtmQueryTypeCname:
+		return text("static_target", m.StaticTarget)
+	case global_lb.GtmQueryTypeMX:
+		return text("priority", m.Priority)
+	case global_lb.GtmQueryTypeSRV:
+		return text("priority", m.Priority) + text("weight", m.Weight) + text("port", m.Port)
+	case global_lb.GtmQueryTypeNAPTR:
+		return text("flags", m.Flags) + text("service", m.Service) + text("regexp", m.Regexp) + text("replacement", m.Replacement)
+	}
+	return ""
+}
+
+// addPoolV2Member adds the member held by n to the typed pool p. The member of an A or AAAA pool is
+// an existing virtual server; any other member is a non-terminal one, backed by a wide IP
+// unless it is a CNAME member with a static target.
+func (c *call) addPoolV2Member(p *Pool, n *node) error {
+	id := virtualServerID(n)
+	m := PoolMember{
+		Name:    id.Name,
+		Server:  id.Server,
+		Order:   n.child("order").int(),
+		Ratio:   n.child("ratio").int(),
+		Enabled: common.StateEnabled,
+	}
+	if m.Ratio == 0 {
+		m.Ratio = 1
+	}
+
+	switch p.Type {
+	case global_lb.GtmQueryTypeA, global_lb.GtmQueryTypeAAAA:
+		if c.m.virtualServer(m.Name, m.Server) == nil {
+			return errNotFound("virtual server", m.Server+":"+m.Name)
+		}
+	default:
+		if m.Server != "" {
+			return errInvalidArgument("The members of %s pools are non-terminal, %s:%s is a virtual server.", p.Type, m.Server, m.Name)
+		}
+		m.Flags, m.Service = n.child("flags").str(), n.child("service").str()
+		m.Regexp, m.Replacement = n.child("regexp").str(), n.child("replacement").str()
+		m.Priority, m.Weight, m.Port = n.child("priority").int(), n.child("weight").int(), n.child("port").int()
+		if p.Type == global_lb.GtmQueryTypeCname {
+			m.StaticTarget = common.StateDisabled
+			if v := n.child("static_target"); v.str() != "" {
+				s, err := enabledState(v)
+				if err != nil {
+					return err
+				}
+				m.StaticTarget = s
+			}
+		}
+		if m.StaticTarget != common.StateEnabled && c.m.wideIPMatching(m.Name) == nil {
+			return errNotFound("wide IP", m.Name)
+		}
+	}
+
+	if p.member(id) != nil {
+		return errAlreadyExists("pool member", memberName(p.Name, id))
+	}
+	p.Members = append(p.Members, m)
+	return nil
+}
+
+// poolMembersByAddress returns the members of the pools named by pool_names
+// identified by the IP:port of their virtual servers in members.
+func (c *call) poolMembersByAddress() ([][]*PoolMember, [][]common.IPPortDefinition, error) {
+	pools, err := c.pools("pool_names")
+	if err != nil {
+		return nil, nil, err
+	}
+	members := c.args.child("members").items()
+	if len(members) != len(pools) {
+		return nil, nil, errLength("pool_names", "members")
+	}
+
+	var res [][]*PoolMember
+	var addrs [][]common.IPPortDefinition
+	for i, p := range pools {
+		var ms []*PoolMember
+		var as []common.IPPortDefinition
+		for _, it := range members[i].items() {
+			addr := common.IPPortDefinition{Address: it.child("address").str(), Port: it.child("port").int()}
+			var found *PoolMember
+			for j := range p.Members {
+				vs := c.m.virtualServer(p.Members[j].Name, p.Members[j].Server)
+				if vs != nil && vs.Destination.Equal(addr) {
+					found = &p.Members[j]
+					break
+				}
+			}
+			if found == nil {
+				return nil, nil, errNotFound("pool member", p.Name+" "+addr.Address+":"+strconv.FormatInt(addr.Port, 10))
+			}
+			ms = append(ms, found)
+			as = append(as, addr)
+		}
+		res = append(res, ms)
+		addrs = append(addrs, as)
+	}
+
+	return res, addrs, nil
+}
+
+func ipPort(a common.IPPortDefinition) string {
+	return text("address", a.Address) + text("port", a.Port)
+}
+
+var poolMemberHandlers = map[string]handler{
+	"get_ratio": func(c *call) (string, error) {
+		members, addrs, err := c.poolMembersByAddress()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(members), func(i int) string {
+			return array(len(members[i]), func(j int) string {
+				return el("member", ipPort(addrs[i][j])) + text("ratio", members[i][j].Ratio)
+			})
+		})), nil
+	},
+	"get_object_status": func(c *call) (string, error) {
+		members, addrs, err := c.poolMembersByAddress()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(members), func(i int) string {
+			return array(len(members[i]), func(j int) string {
+				m := members[i][j]
+				return el("member", ipPort(addrs[i][j])) + el("status", objectStatus(status(m.Status, m.Enabled)))
+			})
+		})), nil
+	},
+}
+
+// virtualServersByDefinition returns the virtual servers matching the definitions of the array virtual_servers.
+func (c *call) virtualServersByDefinition() ([]*VirtualServer, error) {
+	var res []*VirtualServer
+	for _, it := range c.args.child("virtual_servers").items() {
+		name := it.child("name").str()
+		addr := common.IPPortDefinition{Address: it.child("address").str(), Port: it.child("port").int()}
+		var found *VirtualServer
+		for i := range c.m.virtualServers {
+			vs := &c.m.virtualServers[i]
+			if vs.Name == name && vs.Destination.Equal(addr) {
+				found = vs
+				break
+			}
+		}
+		if found == nil {
+			return nil, errNotFound("virtual server", name)
+		}
+		res = append(res, found)
+	}
+	return res, nil
+}
+
+func definition(vs *VirtualServer) string {
+	return text("name", vs.Name) + ipPort(vs.Destination)
+}
+
+var virtualServerHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var vss []*VirtualServer
+		for i := range c.m.virtualServers {
+			if c.listed(c.m.virtualServers[i].Server) {
+				vss = append(vss, &c.m.virtualServers[i])
+			}
+		}
+		return ret(array(len(vss), func(i int) string { return definition(vss[i]) })), nil
+	},
+	"get_server": func(c *call) (string, error) {
+		vss, err := c.virtualServersByDefinition()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(vss), func(i int) string { return esc(vss[i].Server) })), nil
+	},
+	"get_monitor_association": func(c *call) (string, error) {
+		vss, err := c.virtualServersByDefinition()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(vss), func(i int) string {
+			return el("virtual_server", definition(vss[i])) + el("monitor_rule", monitorRuleXML(vss[i].Monitor))
+		})), nil
+	},
+}
+
+var virtualServerV2Handlers = map[string]handler{
+	"get_address": func(c *call) (string, error) {
+		var vss []*VirtualServer
+		for _, it := range c.args.child("virtual_servers").items() {
+			id := virtualServerID(it)
+			vs := c.m.virtualServer(id.Name, c.path(id.Server))
+			if vs == nil {
+				return "", errNotFound("virtual server", c.path(id.Server)+":"+id.Name)
+			}
+			vss = append(vss, vs)
+		}
+		return ret(array(len(vss), func(i int) string { return ipPort(vss[i].Destination) })), nil
+	},
+}
+
+var dataCenterHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var names []string
+		for _, dc := range c.m.dataCenters {
+			if c.listed(dc.Name) {
+				names = append(names, dc.Name)
+			}
+		}
+		return ret(values(names)), nil
+	},
+	"get_server": func(c *call) (string, error) {
+		var dcs []*DataCenter
+		for _, name := range c.args.child("data_centers").strings() {
+			dc := c.m.dataCenter(c.path(name))
+			if dc == nil {
+				return "", errNotFound("data center", c.path(name))
+			}
+			dcs = append(dcs, dc)
+		}
+		return ret(array(len(dcs), func(i int) string {
+			return text("data_center", dcs[i].Name) + el("servers", values(dcs[i].Servers))
+		})), nil
+	},
+}
+
+// wideIPs returns the A wide IPs named by the array wide_ips.
+func (c *call) wideIPs() ([]*WideIP, error) {
+	var res []*WideIP
+	for _, name := range c.args.child("wide_ips").strings() {
+		w := c.m.wideIP(c.path(name), global_lb.GtmQueryTypeA)
+		if w == nil {
+			return nil, errNotFound("wide IP", c.path(name))
+		}
+		res = append(res, w)
+	}
+	return res, nil
+}
+
+// wideIPsByID returns the wide IPs identified by the WideIPID array wide_ips.
+func (c *call) wideIPsByID() ([]*WideIP, error) {
+	var res []*WideIP
+	for _, it := range c.args.child("wide_ips").items() {
+		id := wideIPID(it)
+		w := c.m.wideIP(c.path(id.WideIPName), id.WideIPType)
+		if w == nil {
+			return nil, errNotFound("wide IP", c.path(id.WideIPName))
+		}
+		res = append(res, w)
+	}
+	return res, nil
+}
+
+func wideIPGetter(byID bool, f func(w *WideIP) string) handler {
+	return func(c *call) (string, error) {
+		get := c.wideIPs
+		if byID {
+			get = c.wideIPsByID
+		}
+		wideIPs, err := get()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(wideIPs), func(i int) string { return f(wideIPs[i]) })), nil
+	}
+}
+
+var wideIPHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var names []string
+		for _, w := range c.m.wideIPs {
+			if w.Type == global_lb.GtmQueryTypeA && c.listed(w.Name) {
+				names = append(names, w.Name)
+			}
+		}
+		return ret(values(names)), nil
+	},
+	"get_wideip_pool": wideIPGetter(false, func(w *WideIP) string {
+		return array(len(w.Pools), func(i int) string {
+			return text("pool_name", w.Pools[i].Name) + text("order", w.Pools[i].Order) + text("ratio", w.Pools[i].Ratio)
+		})
+	}),
+	"get_lb_method": wideIPGetter(false, func(w *WideIP) string {
+		return esc(w.LBMethod)
+	}),
+	"get_object_status": wideIPGetter(false, func(w *WideIP) string {
+		return objectStatus(status(w.Status, w.Enabled))
+	}),
+	"get_enabled_state": wideIPGetter(false, func(w *WideIP) string {
+		return esc(w.Enabled)
+	}),
+}
+
+func wideIPPoolIDs(w *WideIP) []global_lb.PoolID {
+	var ids []global_lb.PoolID
+	for _, p := range w.Pools {
+		ids = append(ids, global_lb.PoolID{PoolName: p.Name, PoolType: w.Type})
+	}
+	return ids
+}
+
+var wideIPV2Handlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var ids []global_lb.WideIPID
+		for _, w := range c.m.wideIPs {
+			if c.listed(w.Name) {
+				ids = append(ids, w.ID())
+			}
+		}
+		return ret(values(ids)), nil
+	},
+	"get_list_by_type": func(c *call) (string, error) {
+		types := c.args.child("types").strings()
+		return ret(array(len(types), func(i int) string {
+			var ids []global_lb.WideIPID
+			for _, w := range c.m.wideIPs {
+				if string(w.Type) == types[i] && c.listed(w.Name) {
+					ids = append(ids, w.ID())
+				}
+			}
+			return values(ids)
+		})), nil
+	},
+	"get_wide_ip_pool": wideIPGetter(true, func(w *WideIP) string {
+		return values(wideIPPoolIDs(w))
+	}),
+	"get_lb_method": wideIPGetter(true, func(w *WideIP) string {
+		return esc(w.LBMethod)
+	}),
+	"get_object_status": wideIPGetter(true, func(w *WideIP) string {
+		return objectStatus(status(w.Status, w.Enabled))
+	}),
+	"get_enabled_state": wideIPGetter(true, func(w *WideIP) string {
+		return esc(w.Enabled)
+	}),
+	"get_wide_ip_pool_ratio": func(c *call) (string, error) {
+		wideIPs, err := c.wideIPsByID()
+		if err != nil {
+			return "", err
+		}
+		pools := c.args.child("wide_ip_pools").items()
+		if len(pools) != len(wideIPs) {
+			return "", errLength("wide_ips", "wide_ip_pools")
+		}
+		var ratios [][]int64
+		for i, w := range wideIPs {
+			var r []int64
+			for _, it := range pools[i].items() {
+				id := poolID(it)
+				var found *WideIPPool
+				for j := range w.Pools {
+					if w.Pools[j].Name == c.path(id.PoolName) && queryType(id.PoolType) == w.Type {
+						found = &w.Pools[j]
+						break
+					}
+				}
+				if found == nil {
+					return "", errNotFound("wide IP pool", w.Name+" "+c.path(id.PoolName))
+				}
+				r = append(r, found.Ratio)
+			}
+			ratios = append(ratios, r)
+		}
+		return ret(array(len(ratios), func(i int) string { return values(ratios[i]) })), nil
+	},
+}
+
+// monitors returns the monitor templates named by the array template_names.
+func (c *call) monitors() ([]*Monitor, error) {
+	var res []*Monitor
+	for _, name := range c.args.child("template_names").strings() {
+		m := c.m.monitor(c.path(name))
+		if m == nil {
+			return nil, errNotFound("monitor", c.path(name))
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func monitorGetter(f func(m *Monitor) string) handler {
+	return func(c *call) (string, error) {
+		monitors, err := c.monitors()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(monitors), func(i int) string { return f(monitors[i]) })), nil
+	}
+}
+
+// monitorProperties answers the operations returning a property of each template,
+// the properties being named by the parallel array arg.
+func monitorProperties(arg, key string, f func(m *Monitor, name string) string) handler {
+	return func(c *call) (string, error) {
+		monitors, err := c.monitors()
+		if err != nil {
+			return "", err
+		}
+		names := c.args.child(arg).strings()
+		if len(names) != len(monitors) {
+			return "", errLength("template_names", arg)
+		}
+		return ret(array(len(monitors), func(i int) string {
+			return text(key, names[i]) + f(monitors[i], names[i])
+		})), nil
+	}
+}
+
+var monitorHandlers = map[string]handler{
+	"get_template_list": func(c *call) (string, error) {
+		var monitors []*Monitor
+		for i := range c.m.monitors {
+			if c.listed(c.m.monitors[i].Name) {
+				monitors = append(monitors, &c.m.monitors[i])
+			}
+		}
+		return ret(array(len(monitors), func(i int) string {
+			return text("template_name", monitors[i].Name) + text("template_type", monitors[i].Type)
+		})), nil
+	},
+	"get_template_type": monitorGetter(func(m *Monitor) string {
+		return esc(m.Type)
+	}),
+	"get_parent_template": monitorGetter(func(m *Monitor) string {
+		return esc(m.Parent)
+	}),
+	"get_template_address_type": monitorGetter(func(m *Monitor) string {
+		return esc(m.AddressType)
+	}),
+	"get_template_destination": monitorGetter(func(m *Monitor) string {
+		return text("address_type", m.AddressType) + el("ipport", ipPort(m.Destination))
+	}),
+	"get_template_integer_property": monitorProperties("property_types", "type", func(m *Monitor, name string) string {
+		return text("value", m.Integers[name])
+	}),
+	"get_template_string_property": monitorProperties("property_types", "type", func(m *Monitor, name string) string {
+		return text("value", m.Strings[name])
+	}),
+	"get_template_user_defined_string_property": monitorProperties("property_names", "name", func(m *Monitor, name string) string {
+		return text("value", m.UserDefined[name])
+	}),
+	"get_template_state": monitorGetter(func(m *Monitor) string {
+		return esc(m.Enabled)
+	}),
+	"get_template_reverse_mode": monitorGetter(func(m *Monitor) string {
+		return esc(m.Reverse)
+	}),
+	"get_template_transparent_mode": monitorGetter(func(m *Monitor) string {
+		return esc(m.Transparent)
+	}),
+	"get_ignore_down_response_state": monitorGetter(func(m *Monitor) string {
+		return esc(m.IgnoreDownResponse)
+	}),
+}
+
+// proberPools returns the prober pools named by the array pools.
+func (c *call) proberPools() ([]*ProberPool, error) {
+	var res []*ProberPool
+	for _, name := range c.args.child("pools").strings() {
+		p := c.m.proberPool(c.path(name))
+		if p == nil {
+			return nil, errNotFound("prober pool", c.path(name))
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+var proberPoolHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		var names []string
+		for _, p := range c.m.proberPools {
+			if c.listed(p.Name) {
+				names = append(names, p.Name)
+			}
+		}
+		return ret(values(names)), nil
+	},
+	"get_member": func(c *call) (string, error) {
+		pools, err := c.proberPools()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(pools), func(i int) string { return values(pools[i].Members) })), nil
+	},
+	"get_member_order": func(c *call) (string, error) {
+		pools, err := c.proberPools()
+		if err != nil {
+			return "", err
+		}
+		members := c.args.child("members").items()
+		if len(members) != len(pools) {
+			return "", errLength("pools", "members")
+		}
+		var orders [][]int64
+		for i, p := range pools {
+			var o []int64
+			for _, server := range members[i].strings() {
+				order := -1
+				for j, m := range p.Members {
+					if m == server {
+						order = j
+					}
+				}
+				if order < 0 {
+					return "", errNotFound("prober pool member", p.Name+" "+server)
+				}
+				o = append(o, int64(order))
+			}
+			orders = append(orders, o)
+		}
+		return ret(array(len(orders), func(i int) string { return values(orders[i]) })), nil
+	},
+}
+
+func regionItem(it RegionItem) string {
+	return text("content", it.Content) + text("type", it.Type) + text("negate", it.Negate)
+}
+
+func parseRegionItem(n *node) RegionItem {
+	return RegionItem{
+		Content: n.child("content").str(),
+		Type:    global_lb.RegionType(n.child("type").str()),
+		Negate:  n.child("negate").bool(),
+	}
+}
+
+var regionHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		return ret(array(len(c.m.regions), func(i int) string {
+			return text("name", c.m.regions[i].Name) + text("db_type", c.m.regions[i].DBType)
+		})), nil
+	},
+	"get_region_item": func(c *call) (string, error) {
+		var regions []*Region
+		for _, it := range c.args.child("regions").items() {
+			r := c.m.region(c.path(it.child("name").str()))
+			if r == nil {
+				return "", errNotFound("region", c.path(it.child("name").str()))
+			}
+			regions = append(regions, r)
+		}
+		return ret(array(len(regions), func(i int) string {
+			return array(len(regions[i].Items), func(j int) string { return regionItem(regions[i].Items[j]) })
+		})), nil
+	},
+}
+
+var topologyHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		return ret(array(len(c.m.topology), func(i int) string {
+			return el("server", regionItem(c.m.topology[i].Server)) + el("ldns", regionItem(c.m.topology[i].LDNS))
+		})), nil
+	},
+	"get_order": func(c *call) (string, error) {
+		var orders []int64
+		for _, it := range c.args.child("records").items() {
+			server, ldns := parseRegionItem(it.child("server")), parseRegionItem(it.child("ldns"))
+			found := false
+			for _, r := range c.m.topology {
+				if r.Server == server && r.LDNS == ldns {
+					orders = append(orders, r.Order)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return "", errNotFound("topology record", "server: "+server.Content+" ldns: "+ldns.Content)
+			}
+		}
+		return ret(values(orders)), nil
+	},
+}
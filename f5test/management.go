@@ -0,0 +1,195 @@
+package f5test
+
+import (
+	"fmt"
+
+	"github.com/wule61/go-f5-soap/management"
+)
+
+func init() {
+	register("urn:iControl:Management/View", viewHandlers)
+	register("urn:iControl:Management/Zone", zoneHandlers)
+	register("urn:iControl:Management/ResourceRecord", resourceRecordHandlers)
+}
+
+// zoneNames returns the zones of a view, those it lists and those configured in it.
+func (m *model) zoneNames(v *management.ViewInfo) []string {
+	names := append([]string(nil), v.ZoneNames...)
+	for _, z := range m.zones {
+		if z.ViewName != v.ViewName {
+			continue
+		}
+		found := false
+		for _, n := range names {
+			if n == z.ZoneName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			names = append(names, z.ZoneName)
+		}
+	}
+	return names
+}
+
+func viewInfo(m *model, v *management.ViewInfo) string {
+	return text("view_name", v.ViewName) +
+		text("view_order", v.ViewOrder) +
+		el("option_seq", values(v.OptionSeq)) +
+		el("zone_names", values(m.zoneNames(v)))
+}
+
+// views returns the views named by the array view_names.
+func (c *call) views() ([]*management.ViewInfo, error) {
+	var res []*management.ViewInfo
+	for _, name := range c.args.child("view_names").strings() {
+		v := c.m.view(name)
+		if v == nil {
+			return nil, errNotFound("view", name)
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// zones returns the zones identified by the ViewZone array view_zones.
+func (c *call) zones() ([]*Zone, error) {
+	var res []*Zone
+	for _, it := range c.args.child("view_zones").items() {
+		view, name := it.child("view_name").str(), it.child("zone_name").str()
+		z := c.m.zone(view, name)
+		if z == nil {
+			return nil, errNotFound("zone", view+":"+name)
+		}
+		res = append(res, z)
+	}
+	return res, nil
+}
+
+var viewHandlers = map[string]handler{
+	"get_list": func(c *call) (string, error) {
+		return ret(array(len(c.m.views), func(i int) string { return viewInfo(c.m, &c.m.views[i]) })), nil
+	},
+	"get_view": func(c *call) (string, error) {
+		views, err := c.views()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(views), func(i int) string { return viewInfo(c.m, views[i]) })), nil
+	},
+}
+
+func zoneInfo(z *Zone) string {
+	return text("view_name", z.ViewName) +
+		text("zone_name", z.ZoneName) +
+		text("zone_type", z.ZoneType) +
+		text("zone_file", z.ZoneFile) +
+		el("option_seq", values(z.OptionSeq))
+}
+
+func zoneGetter(c *call) (string, error) {
+	zones, err := c.zones()
+	if err != nil {
+		return "", err
+	}
+	return ret(array(len(zones), func(i int) string { return zoneInfo(zones[i]) })), nil
+}
+
+var zoneHandlers = map[string]handler{
+	"get_zone_name": func(c *call) (string, error) {
+		views, err := c.views()
+		if err != nil {
+			return "", err
+		}
+		var ids []management.ViewZone
+		for _, v := range views {
+			for _, name := range c.m.zoneNames(v) {
+				ids = append(ids, management.ViewZone{ViewName: v.ViewName, ZoneName: name})
+			}
+		}
+		return ret(values(ids)), nil
+	},
+	"get_zone":    zoneGetter,
+	"get_zone_v2": zoneGetter,
+}
+
+// records renders the resource records of a zone as the lines of a zone file.
+func records(rrs management.RRList) []string {
+
+	var res []string
+	add := func(name string, ttl int64, typ string, data string) {
+		res = append(res, fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, ttl, typ, data))
+	}
+
+	for _, r := range rrs.SOAList {
+		add(r.DomainName, r.TTL, "SOA", fmt.Sprintf("%s %s %d %d %d %d %d", r.Primary, r.Email, r.Serial, r.Refresh, r.Retry, r.Expire, r.NegTTL))
+	}
+	for _, r := range rrs.NSList {
+		add(r.DomainName, r.TTL, "NS", r.HostName)
+	}
+	for _, r := range rrs.AList {
+		add(r.DomainName, r.TTL, "A", r.IPAddress)
+	}
+	for _, r := range rrs.AAAAList {
+		add(r.DomainName, r.TTL, "AAAA", r.IPAddress)
+	}
+	for _, r := range rrs.CNAMEList {
+		add(r.DomainName, r.TTL, "CNAME", r.Cname)
+	}
+	for _, r := range rrs.PTRList {
+		add(r.IPAddress, r.TTL, "PTR", r.Dname)
+	}
+	for _, r := range rrs.MXList {
+		add(r.DomainName, r.TTL, "MX", fmt.Sprintf("%d %s", r.Preference, r.Mail))
+	}
+	for _, r := range rrs.TXTList {
+		add(r.DomainName, r.TTL, "TXT", fmt.Sprintf("%q", r.Text))
+	}
+	for _, r := range rrs.SRVList {
+		add(r.DomainName, r.TTL, "SRV", fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Target))
+	}
+	for _, r := range rrs.HInfoList {
+		add(r.DomainName, r.TTL, "HINFO", fmt.Sprintf("%q %q", r.Hardware, r.OS))
+	}
+	for _, r := range rrs.DNAMEList {
+		add(r.Label, r.TTL, "DNAME", r.DomainName)
+	}
+
+	return res
+}
+
+func rrList(rrs management.RRList) string {
+	return el("a_list", values(rrs.AList)) +
+		el("ns_list", values(rrs.NSList)) +
+		el("cname_list", values(rrs.CNAMEList)) +
+		el("soa_list", values(rrs.SOAList)) +
+		el("ptr_list", values(rrs.PTRList)) +
+		el("hinfo_list", values(rrs.HInfoList)) +
+		el("mx_list", values(rrs.MXList)) +
+		el("txt_list", values(rrs.TXTList)) +
+		el("srv_list", values(rrs.SRVList)) +
+		el("key_list", values(rrs.KeyList)) +
+		el("sig_list", values(rrs.SIGList)) +
+		el("nxt_list", values(rrs.NXTList)) +
+		el("aaaa_list", values(rrs.AAAAList)) +
+		el("a6_list", values(rrs.A6List)) +
+		el("dname_list", values(rrs.DNAMEList))
+}
+
+var resourceRecordHandlers = map[string]handler{
+	"get_rrs": func(c *call) (string, error) {
+		zones, err := c.zones()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(zones), func(i int) string { return values(records(zones[i].Records)) })), nil
+	},
+	"get_rrs_detailed": func(c *call) (string, error) {
+		zones, err := c.zones()
+		if err != nil {
+			return "", err
+		}
+		return ret(array(len(zones), func(i int) string { return rrList(zones[i].Records) })), nil
+	},
+}
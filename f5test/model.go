@@ -0,0 +1,481 @@
+package f5test
+
+import (
+	"path"
+
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/management"
+)
+
+// Pool is a GTM pool of the emulated device.
+type Pool struct {
+	Name                     string                 // The full name of the pool, e.g. /Common/pool1.
+	Type                     global_lb.GTMQueryType // The type of the pool, GTM_QUERY_TYPE_A when empty.
+	PreferredLBMethod        global_lb.LBMethod
+	AlternateLBMethod        global_lb.LBMethod
+	FallbackLBMethod         global_lb.LBMethod
+	TTL                      int64
+	VerifyMemberAvailability common.EnabledState
+	AnswersToReturn          int64
+	Enabled                  common.EnabledState // STATE_ENABLED when empty.
+	Status                   common.ObjectStatus // Derived from Enabled when empty.
+	Monitor                  global_lb.MonitorRule
+	Members                  []PoolMember
+	FallbackIP               string
+	Limits                   []global_lb.MetricLimit
+	QoS                      global_lb.QoSCoefficients // The defaults of the device when zero.
+	ManualResume             common.EnabledState       // STATE_DISABLED when empty.
+	DynamicRatio             common.EnabledState       // STATE_DISABLED when empty.
+	CNAME                    string
+	Description              string
+	Statistics               map[common.StatisticType]uint64
+}
+
+// ID returns the PoolV2 identifier of the pool.
+func (p Pool) ID() global_lb.PoolID {
+	return global_lb.PoolID{PoolName: p.Name, PoolType: p.Type}
+}
+
+// PoolMember is a member of a pool. Members of A and AAAA pools are virtual servers,
+// members of other pools are identified by Name only.
+type PoolMember struct {
+	Name    string // The virtual server name, or the dname of a non-terminal member.
+	Server  string // The server of the virtual server.
+	Ratio   int64
+	Order   int64
+	Enabled common.EnabledState
+	Status  common.ObjectStatus
+
+	Limits       []global_lb.MetricLimit
+	Monitor      global_lb.MonitorRule       // MONITOR_RULE_TYPE_NONE when empty.
+	Dependencies []global_lb.VirtualServerID // The virtual servers the member depends on.
+	Description  string
+	Statistics   map[common.StatisticType]uint64
+
+	// The settings of a non-terminal member, those not applying to the type of its pool are left empty.
+	StaticTarget common.EnabledState // CNAME, STATE_DISABLED when empty.
+	Priority     int64               // MX and SRV.
+	Weight       int64               // SRV.
+	Port         int64               // SRV.
+	Flags        string              // NAPTR.
+	Service      string              // NAPTR.
+	Regexp       string              // NAPTR.
+	Replacement  string              // NAPTR.
+}
+
+// ID returns the virtual server identifying the member, its Server being empty for a non-terminal member.
+func (m PoolMember) ID() global_lb.VirtualServerID {
+	return global_lb.VirtualServerID{Name: m.Name, Server: m.Server}
+}
+
+// VirtualServer is a virtual server of a GTM server.
+type VirtualServer struct {
+	Name        string
+	Server      string // The full name of the server, e.g. /Common/bigip1.
+	Destination common.IPPortDefinition
+	Monitor     global_lb.MonitorRule
+	Enabled     common.EnabledState
+	Status      common.ObjectStatus
+}
+
+// DataCenter is a GTM data center and its servers.
+type DataCenter struct {
+	Name    string
+	Servers []string
+}
+
+// WideIP is a GTM wide IP.
+type WideIP struct {
+	Name     string                 // The full name of the wide IP, e.g. /Common/www.example.com.
+	Type     global_lb.GTMQueryType // The type of the wide IP, GTM_QUERY_TYPE_A when empty.
+	LBMethod global_lb.LBMethod     // The pool load balancing method.
+	Enabled  common.EnabledState
+	Status   common.ObjectStatus
+	Pools    []WideIPPool
+}
+
+// ID returns the WideIPV2 identifier of the wide IP.
+func (w WideIP) ID() global_lb.WideIPID {
+	return global_lb.WideIPID{WideIPName: w.Name, WideIPType: w.Type}
+}
+
+// WideIPPool is a pool of a wide IP. The pool has the type of the wide IP.
+type WideIPPool struct {
+	Name  string
+	Order int64
+	Ratio int64
+}
+
+// Monitor is a GTM monitor template.
+type Monitor struct {
+	Name               string
+	Type               string // The template type, e.g. TTYPE_HTTP.
+	Parent             string
+	AddressType        global_lb.AddressType
+	Destination        common.IPPortDefinition
+	Enabled            common.EnabledState
+	IgnoreDownResponse common.EnabledState
+	Reverse            bool
+	Transparent        bool
+	Integers           map[string]int64  // The integer properties by type, e.g. ITYPE_INTERVAL.
+	Strings            map[string]string // The string properties by type, e.g. STYPE_SEND.
+	UserDefined        map[string]string // The user-defined string properties by name.
+}
+
+// ProberPool is a GTM prober pool. Its members are servers, ordered as listed.
+type ProberPool struct {
+	Name    string
+	Members []string
+}
+
+// Region is a GTM topology region.
+type Region struct {
+	Name   string
+	DBType global_lb.RegionDBType
+	Items  []RegionItem
+}
+
+// RegionItem is an item of a region or an endpoint of a topology record.
+type RegionItem struct {
+	Content string
+	Type    global_lb.RegionType
+	Negate  bool
+}
+
+// TopologyRecord is a GTM topology record.
+type TopologyRecord struct {
+	Server RegionItem
+	LDNS   RegionItem
+	Order  int64
+}
+
+// Zone is a DNS zone of a view, with its resource records.
+type Zone struct {
+	management.ZoneInfo
+	Records management.RRList
+}
+
+// model is the configuration of the emulated device.
+type model struct {
+	pools          []Pool
+	virtualServers []VirtualServer
+	dataCenters    []DataCenter
+	wideIPs        []WideIP
+	monitors       []Monitor
+	proberPools    []ProberPool
+	regions        []Region
+	topology       []TopologyRecord
+	views          []management.ViewInfo
+	zones          []Zone
+}
+
+// builtinMonitors are the monitor templates every device ships with.
+var builtinMonitors = []Monitor{
+	{Name: "/Common/gateway_icmp", Type: "TTYPE_GATEWAY_ICMP"},
+	{Name: "/Common/http", Type: "TTYPE_HTTP", Strings: map[string]string{"STYPE_SEND": `GET /\r\n`}},
+	{Name: "/Common/https", Type: "TTYPE_HTTPS", Strings: map[string]string{"STYPE_SEND": `GET /\r\n`}},
+	{Name: "/Common/tcp", Type: "TTYPE_TCP"},
+}
+
+func newModel() *model {
+	m := &model{}
+	for _, mon := range builtinMonitors {
+		m.monitors = append(m.monitors, normalizeMonitor(mon))
+	}
+	return m
+}
+
+// clone returns a deep copy of m, so that a transaction can be applied to it and dropped on failure.
+func (m *model) clone() *model {
+
+	cp := &model{
+		pools:          append([]Pool(nil), m.pools...),
+		virtualServers: append([]VirtualServer(nil), m.virtualServers...),
+		dataCenters:    append([]DataCenter(nil), m.dataCenters...),
+		wideIPs:        append([]WideIP(nil), m.wideIPs...),
+		monitors:       append([]Monitor(nil), m.monitors...),
+		proberPools:    append([]ProberPool(nil), m.proberPools...),
+		regions:        append([]Region(nil), m.regions...),
+		topology:       append([]TopologyRecord(nil), m.topology...),
+		views:          append([]management.ViewInfo(nil), m.views...),
+		zones:          append([]Zone(nil), m.zones...),
+	}
+
+	for i := range cp.pools {
+		cp.pools[i].Members = copyPoolMembers(cp.pools[i].Members)
+		cp.pools[i].Monitor.MonitorTemplates = append([]string(nil), cp.pools[i].Monitor.MonitorTemplates...)
+		cp.pools[i].Limits = append([]global_lb.MetricLimit(nil), cp.pools[i].Limits...)
+		cp.pools[i].Statistics = copyStatistics(cp.pools[i].Statistics)
+	}
+	for i := range cp.virtualServers {
+		cp.virtualServers[i].Monitor.MonitorTemplates = append([]string(nil), cp.virtualServers[i].Monitor.MonitorTemplates...)
+	}
+	for i := range cp.wideIPs {
+		cp.wideIPs[i].Pools = append([]WideIPPool(nil), cp.wideIPs[i].Pools...)
+	}
+	for i := range cp.monitors {
+		cp.monitors[i].Integers = copyMap(cp.monitors[i].Integers)
+		cp.monitors[i].Strings = copyStrings(cp.monitors[i].Strings)
+		cp.monitors[i].UserDefined = copyStrings(cp.monitors[i].UserDefined)
+	}
+
+	return cp
+}
+
+func copyMap(m map[string]int64) map[string]int64 {
+	cp := make(map[string]int64, len(m))
+	for k, v := range m {
+		cp[k] = v
+	}
+	return cp
+}
+
+func copyPoolMembers(ms []PoolMember) []PoolMember {
+	cp := append([]PoolMember(nil), ms...)
+	for i := range cp {
+		cp[i].Limits = append([]global_lb.MetricLimit(nil), cp[i].Limits...)
+		cp[i].Monitor.MonitorTemplates = append([]string(nil), cp[i].Monitor.MonitorTemplates...)
+		cp[i].Dependencies = append([]global_lb.VirtualServerID(nil), cp[i].Dependencies...)
+		if cp[i].Statistics != nil {
+			cp[i].Statistics = copyStatistics(cp[i].Statistics)
+		}
+	}
+	return cp
+}
+
+func copyStatistics(m map[common.StatisticType]uint64) map[common.StatisticType]uint64 {
+	cp := make(map[common.StatisticType]uint64, len(m))
+	for k, v := range m {
+		cp[k] = v
+	}
+	return cp
+}
+
+func copyStrings(m map[string]string) map[string]string {
+	cp := make(map[string]string, len(m))
+	for k, v := range m {
+		cp[k] = v
+	}
+	return cp
+}
+
+// status returns the object status reported for an object, derived from its enabled state unless set.
+func status(s common.ObjectStatus, enabled common.EnabledState) common.ObjectStatus {
+	if s.AvailabilityStatus != "" {
+		return s
+	}
+	if enabled == common.StateDisabled {
+		return common.ObjectStatus{
+			AvailabilityStatus: common.AvailabilityStatusBlue,
+			EnabledStatus:      common.EnabledStatusDisabled,
+			StatusDescription:  "The object has been disabled",
+		}
+	}
+	return common.ObjectStatus{
+		AvailabilityStatus: common.AvailabilityStatusGreen,
+		EnabledStatus:      common.EnabledStatusEnabled,
+		StatusDescription:  "Available",
+	}
+}
+
+func enabled(s common.EnabledState) common.EnabledState {
+	if s == "" {
+		return common.StateEnabled
+	}
+	return s
+}
+
+func queryType(t global_lb.GTMQueryType) global_lb.GTMQueryType {
+	if t == "" {
+		return global_lb.GtmQueryTypeA
+	}
+	return t
+}
+
+func monitorRule(r global_lb.MonitorRule) global_lb.MonitorRule {
+	if r.Type == "" {
+		r.Type = global_lb.MonitorRuleTypeNone
+	}
+	return r
+}
+
+// defaultQoS are the QoS coefficients of a pool created without them.
+var defaultQoS = global_lb.QoSCoefficients{RTT: 50, HitRatio: 5, PacketRate: 1, BPS: 3, LinkCapacity: 30}
+
+func normalizePool(p Pool) Pool {
+	p.Type = queryType(p.Type)
+	p.Enabled = enabled(p.Enabled)
+	p.Monitor = monitorRule(p.Monitor)
+	if p.PreferredLBMethod == "" {
+		p.PreferredLBMethod = global_lb.LBMethodRoundRobin
+	}
+	if p.AlternateLBMethod == "" {
+		p.AlternateLBMethod = global_lb.LBMethodRoundRobin
+	}
+	if p.FallbackLBMethod == "" {
+		p.FallbackLBMethod = global_lb.LBMethodReturnToDNS
+	}
+	if p.TTL == 0 {
+		p.TTL = 30
+	}
+	if p.VerifyMemberAvailability == "" {
+		p.VerifyMemberAvailability = common.StateEnabled
+	}
+	if p.AnswersToReturn == 0 {
+		p.AnswersToReturn = 1
+	}
+	if p.QoS == (global_lb.QoSCoefficients{}) {
+		p.QoS = defaultQoS
+	}
+	if p.ManualResume == "" {
+		p.ManualResume = common.StateDisabled
+	}
+	if p.DynamicRatio == "" {
+		p.DynamicRatio = common.StateDisabled
+	}
+	p.Limits = append([]global_lb.MetricLimit(nil), p.Limits...)
+	p.Statistics = copyStatistics(p.Statistics)
+	p.Members = copyPoolMembers(p.Members)
+	for i := range p.Members {
+		p.Members[i].Enabled = enabled(p.Members[i].Enabled)
+		if p.Members[i].Ratio == 0 {
+			p.Members[i].Ratio = 1
+		}
+		if p.Type == global_lb.GtmQueryTypeCname && p.Members[i].StaticTarget == "" {
+			p.Members[i].StaticTarget = common.StateDisabled
+		}
+	}
+	return p
+}
+
+func normalizeWideIP(w WideIP) WideIP {
+	w.Type = queryType(w.Type)
+	w.Enabled = enabled(w.Enabled)
+	if w.LBMethod == "" {
+		w.LBMethod = global_lb.LBMethodRoundRobin
+	}
+	w.Pools = append([]WideIPPool(nil), w.Pools...)
+	for i := range w.Pools {
+		if w.Pools[i].Ratio == 0 {
+			w.Pools[i].Ratio = 1
+		}
+	}
+	return w
+}
+
+func normalizeMonitor(m Monitor) Monitor {
+	m.Enabled = enabled(m.Enabled)
+	if m.IgnoreDownResponse == "" {
+		m.IgnoreDownResponse = common.StateDisabled
+	}
+	if m.AddressType == "" {
+		m.AddressType = global_lb.ATypeStarAddressStarPort
+	}
+	if m.Destination.Address == "" {
+		m.Destination.Address = "0.0.0.0"
+	}
+	m.Integers = copyMap(m.Integers)
+	if _, ok := m.Integers["ITYPE_INTERVAL"]; !ok {
+		m.Integers["ITYPE_INTERVAL"] = 30
+	}
+	if _, ok := m.Integers["ITYPE_TIMEOUT"]; !ok {
+		m.Integers["ITYPE_TIMEOUT"] = 120
+	}
+	m.Strings = copyStrings(m.Strings)
+	m.UserDefined = copyStrings(m.UserDefined)
+	return m
+}
+
+func (m *model) pool(name string, t global_lb.GTMQueryType) *Pool {
+	t = queryType(t)
+	for i := range m.pools {
+		if m.pools[i].Name == name && m.pools[i].Type == t {
+			return &m.pools[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) virtualServer(name, server string) *VirtualServer {
+	for i := range m.virtualServers {
+		if m.virtualServers[i].Name == name && m.virtualServers[i].Server == server {
+			return &m.virtualServers[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) dataCenter(name string) *DataCenter {
+	for i := range m.dataCenters {
+		if m.dataCenters[i].Name == name {
+			return &m.dataCenters[i]
+		}
+	}
+	return nil
+}
+
+// wideIPMatching returns a wide IP whose name, without its folder, matches dname exactly or as a wildcard.
+func (m *model) wideIPMatching(dname string) *WideIP {
+	for i := range m.wideIPs {
+		if ok, _ := path.Match(path.Base(m.wideIPs[i].Name), dname); ok {
+			return &m.wideIPs[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) wideIP(name string, t global_lb.GTMQueryType) *WideIP {
+	t = queryType(t)
+	for i := range m.wideIPs {
+		if m.wideIPs[i].Name == name && m.wideIPs[i].Type == t {
+			return &m.wideIPs[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) monitor(name string) *Monitor {
+	for i := range m.monitors {
+		if m.monitors[i].Name == name {
+			return &m.monitors[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) proberPool(name string) *ProberPool {
+	for i := range m.proberPools {
+		if m.proberPools[i].Name == name {
+			return &m.proberPools[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) region(name string) *Region {
+	for i := range m.regions {
+		if m.regions[i].Name == name {
+			return &m.regions[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) view(name string) *management.ViewInfo {
+	for i := range m.views {
+		if m.views[i].ViewName == name {
+			return &m.views[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) zone(view, name string) *Zone {
+	for i := range m.zones {
+		if m.zones[i].ViewName == view && m.zones[i].ZoneName == name {
+			return &m.zones[i]
+		}
+	}
+	return nil
+}
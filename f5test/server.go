@@ -0,0 +1,473 @@
+// Package f5test provides an in-process emulator of the iControl portal of a BIG-IP,
+// holding an in-memory GTM/DNS configuration, to test code built on this module without a device.
+//
+//	s := f5test.NewServer(t)
+//	s.AddPools(f5test.Pool{Name: "/Common/pool1", TTL: 60})
+//	ttl, err := pool.New(s.Client()).GetTTL([]string{"/Common/pool1"})
+//
+// The server answers the operations implemented by this module with the envelopes and
+// the faults of a device, e.g. a Common::OperationFailed fault for a missing object.
+package f5test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/management"
+	"github.com/wule61/go-f5-soap/system"
+)
+
+const (
+	// PortalPath is the path of the iControl portal.
+	PortalPath = "/iControl/iControlPortal.cgi"
+
+	// DefaultUsername and DefaultPassword are the credentials accepted by a server unless WithCredentials is used.
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+
+	// DefaultVersion is the version reported by a server unless WithVersion is used.
+	DefaultVersion = "BIG-IP_v15.1.0"
+
+	sessionHeader = "X-iControl-Session"
+	tokenHeader   = "X-F5-Auth-Token"
+	loginPath     = "/mgmt/shared/authn/login"
+)
+
+// Call is an operation received by the server.
+type Call struct {
+	Namespace string // The namespace of the interface, e.g. urn:iControl:GlobalLB/Pool.
+	Operation string // The operation name, e.g. get_list.
+	Session   string // The X-iControl-Session header of the call, if any.
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the credentials accepted by the server.
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.username, s.password = username, password
+	}
+}
+
+// WithVersion sets the version returned by System.SystemInfo get_version.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithFailoverState sets the state returned by System.Failover get_failover_state,
+// FAILOVER_STATE_ACTIVE by default.
+func WithFailoverState(state system.FailoverState) Option {
+	return func(s *Server) {
+		s.failover = state
+	}
+}
+
+// Server is an emulated BIG-IP. Its configuration is seeded with the Add methods.
+type Server struct {
+	srv      *httptest.Server
+	username string
+	password string
+	version  string
+	started  time.Time
+
+	mu          sync.Mutex
+	failover    system.FailoverState
+	m           *model
+	sessions    map[string]*session
+	lastSession int64
+	tokens      map[string]bool
+	calls       []Call
+}
+
+// session is the state of an iControl session.
+type session struct {
+	folder    string
+	recursive bool
+	inTx      bool
+	tx        []request
+}
+
+// request is a mutating operation queued in a transaction.
+type request struct {
+	namespace string
+	op        string
+	args      *node
+}
+
+// NewServer starts a server, closed when the test completes.
+func NewServer(t testing.TB, opts ...Option) *Server {
+
+	s := &Server{
+		username: DefaultUsername,
+		password: DefaultPassword,
+		version:  DefaultVersion,
+		failover: system.FailoverStateActive,
+		started:  time.Now(),
+		m:        newModel(),
+		sessions: map[string]*session{},
+		tokens:   map[string]bool{},
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.srv = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// URL returns the URL of the iControl portal of the server.
+func (s *Server) URL() string {
+	return s.srv.URL + PortalPath
+}
+
+// Client returns a client of the server authenticated with its credentials.
+// The options are applied after the credentials.
+func (s *Server) Client(opts ...soap.Option) *soap.Client {
+	return soap.NewClient(s.URL(), append([]soap.Option{soap.WithBasicAuth(s.username, s.password)}, opts...)...)
+}
+
+// Calls returns the operations received by the server, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+// AddPools adds pools to the configuration.
+func (s *Server) AddPools(pools ...Pool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range pools {
+		s.m.pools = append(s.m.pools, normalizePool(p))
+	}
+}
+
+// AddVirtualServers adds virtual servers to the configuration.
+func (s *Server) AddVirtualServers(vss ...VirtualServer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, vs := range vss {
+		vs.Enabled = enabled(vs.Enabled)
+		vs.Monitor = monitorRule(vs.Monitor)
+		s.m.virtualServers = append(s.m.virtualServers, vs)
+	}
+}
+
+// AddDataCenters adds data centers to the configuration.
+func (s *Server) AddDataCenters(dcs ...DataCenter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.dataCenters = append(s.m.dataCenters, dcs...)
+}
+
+// AddWideIPs adds wide IPs to the configuration.
+func (s *Server) AddWideIPs(wideIPs ...WideIP) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, w := range wideIPs {
+		s.m.wideIPs = append(s.m.wideIPs, normalizeWideIP(w))
+	}
+}
+
+// AddMonitors adds monitor templates to the configuration, next to the built-in ones.
+func (s *Server) AddMonitors(monitors ...Monitor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range monitors {
+		s.m.monitors = append(s.m.monitors, normalizeMonitor(m))
+	}
+}
+
+// AddProberPools adds prober pools to the configuration.
+func (s *Server) AddProberPools(pools ...ProberPool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.proberPools = append(s.m.proberPools, pools...)
+}
+
+// AddRegions adds topology regions to the configuration.
+func (s *Server) AddRegions(regions ...Region) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.regions = append(s.m.regions, regions...)
+}
+
+// AddTopologyRecords adds topology records to the configuration.
+func (s *Server) AddTopologyRecords(records ...TopologyRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.topology = append(s.m.topology, records...)
+}
+
+// AddViews adds DNS views to the configuration.
+func (s *Server) AddViews(views ...management.ViewInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.views = append(s.m.views, views...)
+}
+
+// AddZones adds DNS zones to the configuration. The zones are listed in their views.
+func (s *Server) AddZones(zones ...Zone) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m.zones = append(s.m.zones, zones...)
+}
+
+// SetFailoverState changes the failover state of the server, e.g. to emulate a failover of an HA pair.
+// A server not active rejects the configuration changes with a Common::OperationFailed fault.
+func (s *Server) SetFailoverState(state system.FailoverState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failover = state
+}
+
+// Pool returns the pool named name of type t, GTM_QUERY_TYPE_A when empty.
+func (s *Server) Pool(name string, t global_lb.GTMQueryType) (Pool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.m.pool(name, t)
+	if p == nil {
+		return Pool{}, false
+	}
+	cp := *p
+	cp.Members = append([]PoolMember(nil), p.Members...)
+	return cp, true
+}
+
+// WideIP returns the wide IP named name of type t, GTM_QUERY_TYPE_A when empty.
+func (s *Server) WideIP(name string, t global_lb.GTMQueryType) (WideIP, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := s.m.wideIP(name, t)
+	if w == nil {
+		return WideIP{}, false
+	}
+	cp := *w
+	cp.Pools = append([]WideIPPool(nil), w.Pools...)
+	return cp, true
+}
+
+// call is the context of an operation handled by the server.
+type call struct {
+	s    *Server
+	m    *model
+	sess *session
+	args *node
+}
+
+// handler answers an operation with the content of its response element.
+type handler func(c *call) (string, error)
+
+var handlers = map[string]handler{}
+
+// register makes the server answer the operations of namespace.
+func register(namespace string, ops map[string]handler) {
+	for op, h := range ops {
+		handlers[namespace+" "+op] = h
+	}
+}
+
+// path returns the full name of an object, resolving names without folder against the active folder.
+func (c *call) path(name string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	return c.sess.folder + "/" + name
+}
+
+// listed reports whether an object is returned by the get_list methods of the session.
+func (c *call) listed(name string) bool {
+	if c.sess.recursive {
+		return strings.HasPrefix(name, c.sess.folder+"/")
+	}
+	return path.Dir(name) == c.sess.folder
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path == loginPath && r.Method == http.MethodPost {
+		s.login(w, r)
+		return
+	}
+	if r.URL.Path != PortalPath || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Enterprise Manager"`)
+		w.Header().Set("Content-Type", "text/html; charset=iso-8859-1")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, "<html><head><title>401 Unauthorized</title></head><body><h1>Unauthorized</h1></body></html>")
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.Header().Set("Server", "Apache")
+
+	namespace, op, err := parseRequest(body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprint(w, faultResponse("SOAP-ENV:Client", "Application failed during request deserialization: "+err.Error()))
+		return
+	}
+
+	content, err := s.handle(namespace, op.name, r.Header.Get(sessionHeader), op)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		if f, ok := err.(*fault); ok {
+			_, _ = fmt.Fprint(w, faultResponse(f.code, f.faultString(namespace, op.name)))
+		} else {
+			_, _ = fmt.Fprint(w, faultResponse("", err.Error()))
+		}
+		return
+	}
+
+	_, _ = fmt.Fprint(w, response(namespace, op.name, content))
+}
+
+func (s *Server) handle(namespace, op, sessionID string, args *node) (string, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, Call{Namespace: namespace, Operation: op, Session: sessionID})
+
+	// A unit not active rejects the configuration changes.
+	if s.failover != system.FailoverStateActive && !strings.HasPrefix(namespace, "urn:iControl:System/") && !strings.HasPrefix(op, "get_") {
+		return "", errOperationFailed("Configuration changes are not allowed on a unit in %s state, the device is not active (standby).", string(s.failover))
+	}
+
+	h, ok := handlers[namespace+" "+op]
+	if !ok {
+		class := strings.Replace(strings.TrimPrefix(namespace, "urn:iControl:"), "/", "::", 1)
+		return "", &fault{
+			code:      "SOAP-ENV:Client",
+			exception: "Common::NotImplemented",
+			message:   fmt.Sprintf("Failed to locate method (%s) in class (%s)", op, class),
+		}
+	}
+
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		sess = &session{folder: "/Common"}
+		s.sessions[sessionID] = sess
+	}
+
+	// Changes made in a transaction are only applied when it is submitted.
+	if sess.inTx && namespace != sessionNamespace && !strings.HasPrefix(op, "get_") {
+		sess.tx = append(sess.tx, request{namespace: namespace, op: op, args: args})
+		return "", nil
+	}
+
+	c := &call{s: s, m: s.m, sess: sess, args: args}
+	if namespace == sessionNamespace || strings.HasPrefix(op, "get_") {
+		return h(c)
+	}
+
+	// A change is applied to a copy of the configuration, kept only when the whole call succeeds.
+	c.m = s.m.clone()
+	content, err := h(c)
+	if err == nil {
+		s.m = c.m
+	}
+	return content, err
+}
+
+// submit applies the changes queued in the transaction of sess, all or none.
+func (s *Server) submit(sess *session) error {
+
+	m := s.m.clone()
+	for _, r := range sess.tx {
+		if _, err := handlers[r.namespace+" "+r.op](&call{s: s, m: m, sess: sess, args: r.args}); err != nil {
+			return err
+		}
+	}
+	s.m = m
+
+	return nil
+}
+
+// authorized checks the token or the basic authentication of a request.
+func (s *Server) authorized(r *http.Request) bool {
+
+	if token := r.Header.Get(tokenHeader); token != "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.tokens[token]
+	}
+
+	username, password, ok := r.BasicAuth()
+	return ok && username == s.username && password == s.password
+}
+
+// login answers the iControl REST login used by token authentication.
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if req.Username != s.username || req.Password != s.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"code":401,"message":"Authentication failed.","errorStack":[],"apiError":1}`)
+		return
+	}
+
+	s.mu.Lock()
+	token := "TOKEN" + strconv.Itoa(len(s.tokens)+1)
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"username": req.Username,
+		"token": map[string]interface{}{
+			"token":    token,
+			"userName": req.Username,
+			"timeout":  1200,
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package f5test_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+	"github.com/wule61/go-f5-soap/system/session"
+	"github.com/wule61/go-f5-soap/system/system_info"
+)
+
+func TestServer_BasicAuth(t *testing.T) {
+
+	s := f5test.NewServer(t)
+
+	_, err := system_info.New(soap.NewClient(s.URL(), soap.WithBasicAuth("admin", "wrong"))).GetVersion()
+	var httpErr *soap.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 HTTPError, got %v", err)
+	}
+
+	version, err := system_info.New(s.Client()).GetVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != f5test.DefaultVersion {
+		t.Fatalf("expected version %s, got %s", f5test.DefaultVersion, version)
+	}
+}
+
+func TestServer_TokenAuth(t *testing.T) {
+
+	s := f5test.NewServer(t, f5test.WithCredentials("api", "secret"))
+	c := soap.NewClient(s.URL(), soap.WithTokenAuth(soap.StaticCredentials("api", "secret")))
+
+	if _, err := system_info.New(c).GetVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := s.Calls()
+	if len(calls) != 1 || calls[0].Operation != "get_version" {
+		t.Fatalf("unexpected calls %+v", calls)
+	}
+}
+
+func TestServer_NotFound(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{Name: "/Common/pool1", TTL: 60})
+
+	_, err := pool.New(s.Client()).GetTTL([]string{"/Common/pool1", "/Common/missing"})
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+
+	f, ok := soap.AsFault(err)
+	if !ok {
+		t.Fatalf("expected a fault, got %v", err)
+	}
+	if f.Exception != "Common::OperationFailed" || f.PrimaryErrorCode != soap.ErrorCodeNotFound {
+		t.Fatalf("unexpected fault %+v", f)
+	}
+}
+
+type getLinkListReq struct {
+	soap.BaseEnvEnvelope
+	Body struct {
+		GetList struct{} `xml:"tns:get_list"`
+	} `xml:"env:Body"`
+}
+
+func TestServer_NotImplemented(t *testing.T) {
+
+	s := f5test.NewServer(t)
+
+	_, err := s.Client().Call(context.Background(), getLinkListReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Link"),
+	})
+	f, ok := soap.AsFault(err)
+	if !ok {
+		t.Fatalf("expected a fault, got %v", err)
+	}
+	if f.Exception != "Common::NotImplemented" {
+		t.Fatalf("unexpected fault %+v", f)
+	}
+}
+
+func TestServer_Session(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(
+		f5test.Pool{Name: "/Common/pool1"},
+		f5test.Pool{Name: "/Tenant/pool2"},
+		f5test.Pool{Name: "/Tenant/app/pool3"},
+	)
+
+	ctx := context.Background()
+	c, _, err := session.Open(ctx, s.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess := session.New(c)
+	if err := sess.SetActiveFolder("/Tenant"); err != nil {
+		t.Fatal(err)
+	}
+
+	p := pool.New(c)
+	arr, err := p.GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 1 || arr[0] != "/Tenant/pool2" {
+		t.Fatalf("unexpected pools %v", arr)
+	}
+
+	if err := sess.SetRecursiveQueryState("STATE_ENABLED"); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 2 {
+		t.Fatalf("unexpected pools %v", arr)
+	}
+
+	// Names without folder are resolved against the active folder.
+	ttl, err := p.GetTTL([]string{"pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ttl) != 1 || ttl[0] != 30 {
+		t.Fatalf("unexpected TTL %v", ttl)
+	}
+
+	// Other sessions keep their own state.
+	arr, err = pool.New(s.Client()).GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 1 || arr[0] != "/Common/pool1" {
+		t.Fatalf("unexpected pools %v", arr)
+	}
+}
+
+func TestServer_Transaction(t *testing.T) {
+
+	s := f5test.NewServer(t)
+
+	c, _, err := session.Open(context.Background(), s.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := session.New(c)
+
+	if err := sess.SubmitTransaction(); err == nil {
+		t.Fatal("expected a fault submitting without a transaction")
+	}
+	if err := sess.StartTransaction(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sess.StartTransaction(); err == nil {
+		t.Fatal("expected a fault starting a second transaction")
+	}
+	if err := sess.RollbackTransaction(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sess.RollbackTransaction(); err == nil {
+		t.Fatal("expected a fault rolling back without a transaction")
+	}
+}
+
+func TestServer_Pool(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{
+		Name: "/Common/pool1",
+		Members: []f5test.PoolMember{
+			{Name: "vs1", Server: "/Common/bigip1", Ratio: 3},
+		},
+	})
+
+	p, ok := s.Pool("/Common/pool1", "")
+	if !ok {
+		t.Fatal("pool not found")
+	}
+	if p.Type != global_lb.GtmQueryTypeA || p.TTL != 30 || p.Members[0].Ratio != 3 {
+		t.Fatalf("unexpected pool %+v", p)
+	}
+
+	// The returned pool is a copy.
+	p.Members[0].Ratio = 5
+	if p, _ := s.Pool("/Common/pool1", ""); p.Members[0].Ratio != 3 {
+		t.Fatal("the configuration was modified through a returned pool")
+	}
+
+	if _, ok := s.Pool("/Common/pool1", global_lb.GtmQueryTypeAAAA); ok {
+		t.Fatal("found a pool of another type")
+	}
+}
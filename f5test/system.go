@@ -0,0 +1,81 @@
+package f5test
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/wule61/go-f5-soap/common"
+)
+
+const sessionNamespace = "urn:iControl:System/Session"
+
+func init() {
+
+	register("urn:iControl:System/SystemInfo", map[string]handler{
+		"get_version": func(c *call) (string, error) {
+			return text("return", c.s.version), nil
+		},
+		"get_uptime": func(c *call) (string, error) {
+			return text("return", int64(time.Since(c.s.started)/time.Second)), nil
+		},
+	})
+
+	register("urn:iControl:System/Failover", map[string]handler{
+		"get_failover_state": func(c *call) (string, error) {
+			return text("return", c.s.failover), nil
+		},
+	})
+
+	register(sessionNamespace, map[string]handler{
+		"get_session_identifier": func(c *call) (string, error) {
+			c.s.lastSession++
+			id := c.s.lastSession
+			c.s.sessions[strconv.FormatInt(id, 10)] = &session{folder: "/Common"}
+			return text("return", id), nil
+		},
+		"get_active_folder": func(c *call) (string, error) {
+			return text("return", c.sess.folder), nil
+		},
+		"set_active_folder": func(c *call) (string, error) {
+			folder := c.args.child("folder").str()
+			if len(folder) < 2 || folder[0] != '/' {
+				return "", errNotFound("folder", folder)
+			}
+			c.sess.folder = folder
+			return "", nil
+		},
+		"get_recursive_query_state": func(c *call) (string, error) {
+			state := common.StateDisabled
+			if c.sess.recursive {
+				state = common.StateEnabled
+			}
+			return text("return", state), nil
+		},
+		"set_recursive_query_state": func(c *call) (string, error) {
+			c.sess.recursive = common.EnabledState(c.args.child("state").str()) == common.StateEnabled
+			return "", nil
+		},
+		"start_transaction": func(c *call) (string, error) {
+			if c.sess.inTx {
+				return "", errOperationFailed("A transaction is already open in this session.")
+			}
+			c.sess.inTx, c.sess.tx = true, nil
+			return "", nil
+		},
+		"submit_transaction": func(c *call) (string, error) {
+			if !c.sess.inTx {
+				return "", errOperationFailed("No transaction is open in this session.")
+			}
+			err := c.s.submit(c.sess)
+			c.sess.inTx, c.sess.tx = false, nil
+			return "", err
+		},
+		"rollback_transaction": func(c *call) (string, error) {
+			if !c.sess.inTx {
+				return "", errOperationFailed("No transaction is open in this session.")
+			}
+			c.sess.inTx, c.sess.tx = false, nil
+			return "", nil
+		},
+	})
+}
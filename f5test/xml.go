@@ -0,0 +1,242 @@
+package f5test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// node is a decoded element of a request envelope.
+type node struct {
+	space    string
+	name     string
+	text     string
+	children []*node
+}
+
+// parseRequest decodes an envelope and returns the namespace and the element of the called operation.
+func parseRequest(body []byte) (namespace string, op *node, err error) {
+
+	dec := xml.NewDecoder(bytes.NewReader(body))
+
+	var stack []*node
+	var root *node
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{space: t.Name.Space, name: t.Name.Local}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+
+	if root == nil || root.name != "Envelope" {
+		return "", nil, fmt.Errorf("no SOAP envelope")
+	}
+	b := root.child("Body")
+	if len(b.children) == 0 {
+		return "", nil, fmt.Errorf("no operation in the SOAP body")
+	}
+	op = b.children[0]
+
+	return op.space, op, nil
+}
+
+var emptyNode = &node{}
+
+// child returns the first child element named name, or an empty node.
+func (n *node) child(name string) *node {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return emptyNode
+}
+
+// items returns the item elements of an array.
+func (n *node) items() []*node {
+	var res []*node
+	for _, c := range n.children {
+		if c.name == "item" {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (n *node) str() string {
+	return strings.TrimSpace(n.text)
+}
+
+func (n *node) int() int64 {
+	v, _ := strconv.ParseInt(n.str(), 10, 64)
+	return v
+}
+
+func (n *node) bool() bool {
+	v, _ := strconv.ParseBool(n.str())
+	return v
+}
+
+// strings returns the text of the items of an array.
+func (n *node) strings() []string {
+	var res []string
+	for _, it := range n.items() {
+		res = append(res, it.str())
+	}
+	return res
+}
+
+// el renders an element around already encoded content.
+func el(name, content string) string {
+	return "<" + name + ">" + content + "</" + name + ">"
+}
+
+// esc returns the escaped text of v.
+func esc(v interface{}) string {
+	buf := new(bytes.Buffer)
+	_ = xml.EscapeText(buf, []byte(fmt.Sprint(v)))
+	return buf.String()
+}
+
+// text renders an element holding the escaped value of v.
+func text(name string, v interface{}) string {
+	return el(name, esc(v))
+}
+
+// ret renders the return element of a response.
+func ret(content string) string {
+	return el("return", content)
+}
+
+// array renders the items of an array of n elements, encoding each with f.
+func array(n int, f func(i int) string) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteString(el("item", f(i)))
+	}
+	return b.String()
+}
+
+// values renders a slice of scalars or of structs tagged for encoding/xml as the items of an array.
+func values(slice interface{}) string {
+	v := reflect.ValueOf(slice)
+	buf := new(bytes.Buffer)
+	enc := xml.NewEncoder(buf)
+	for i := 0; i < v.Len(); i++ {
+		_ = enc.EncodeElement(v.Index(i).Interface(), xml.StartElement{Name: xml.Name{Local: "item"}})
+	}
+	_ = enc.Flush()
+	return buf.String()
+}
+
+// fields renders the fields of a struct tagged for encoding/xml, without its enclosing element.
+func fields(v interface{}) string {
+	buf := new(bytes.Buffer)
+	_ = xml.NewEncoder(buf).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "s"}})
+	return strings.TrimSuffix(strings.TrimPrefix(buf.String(), "<s>"), "</s>")
+}
+
+const envelopeHead = `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:SOAP-ENC="http://schemas.xmlsoap.org/soap/encoding/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" SOAP-ENV:encodingStyle="http://schemas.xmlsoap.org/soap/encoding/">
+<SOAP-ENV:Body>
+`
+
+const envelopeTail = `
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+// response renders the envelope answering op of namespace.
+func response(namespace, op, content string) string {
+	return envelopeHead +
+		`<ns1:` + op + `Response xmlns:ns1="` + namespace + `">` + content + `</ns1:` + op + `Response>` +
+		envelopeTail
+}
+
+// fault is an iControl exception raised by a handler.
+type fault struct {
+	code      string // The SOAP faultcode.
+	exception string // The iControl exception class, e.g. Common::OperationFailed.
+	primary   int64  // The primary error code.
+	message   string // The error string.
+}
+
+func (f *fault) Error() string {
+	return f.exception + ": " + f.message
+}
+
+const (
+	errorCodeNotFound      = 0x01020036
+	errorCodeAlreadyExists = 0x01020066
+)
+
+func errNotFound(kind, name string) *fault {
+	return &fault{
+		exception: "Common::OperationFailed",
+		primary:   errorCodeNotFound,
+		message:   fmt.Sprintf("%08x:3: The requested %s (%s) was not found.", errorCodeNotFound, kind, name),
+	}
+}
+
+func errAlreadyExists(kind, name string) *fault {
+	return &fault{
+		exception: "Common::OperationFailed",
+		primary:   errorCodeAlreadyExists,
+		message:   fmt.Sprintf("%08x:3: The requested %s (%s) already exists in partition Common.", errorCodeAlreadyExists, kind, name),
+	}
+}
+
+func errOperationFailed(format string, args ...interface{}) *fault {
+	return &fault{exception: "Common::OperationFailed", message: fmt.Sprintf(format, args...)}
+}
+
+func errInvalidArgument(format string, args ...interface{}) *fault {
+	return &fault{exception: "Common::InvalidArgument", message: fmt.Sprintf(format, args...)}
+}
+
+// errLength is raised when the parallel arrays of a request differ in length.
+func errLength(a, b string) *fault {
+	return errInvalidArgument("Array lengths of %s and %s do not match.", a, b)
+}
+
+// faultString renders the faultstring iControl uses for exceptions raised by op of namespace.
+func (f *fault) faultString(namespace, op string) string {
+	module := strings.TrimPrefix(namespace, "urn:iControl:")
+	if i := strings.Index(module, "/"); i >= 0 {
+		module = module[:i]
+	}
+
+	return fmt.Sprintf("Exception caught in %s::%s::%s()\nException: %s\n\tprimary_error_code   : %d (0x%08X)\n\tsecondary_error_code : 0\n\terror_string         : %s",
+		module, namespace, op, f.exception, f.primary, f.primary, f.message)
+}
+
+// faultResponse renders the SOAP fault carrying faultString.
+func faultResponse(code, faultString string) string {
+	if code == "" {
+		code = "SOAP-ENV:Server"
+	}
+
+	return envelopeHead +
+		`<SOAP-ENV:Fault><faultcode xsi:type="xsd:QName">` + code + `</faultcode><faultstring xsi:type="xsd:string">` + esc(faultString) + `</faultstring></SOAP-ENV:Fault>` +
+		envelopeTail
+}
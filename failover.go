@@ -0,0 +1,257 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// ErrNoActiveEndpoint is returned by a failover client when none of its units answered.
+var ErrNoActiveEndpoint = errors.New("icontrol: no available endpoint")
+
+// EndpointError is the error of a call of a failover client, with the unit which returned it.
+type EndpointError struct {
+	URL string // The iControl portal URL of the unit.
+	Err error
+}
+
+func (e *EndpointError) Error() string {
+	return e.URL + ": " + e.Err.Error()
+}
+
+func (e *EndpointError) Unwrap() error {
+	return e.Err
+}
+
+// NewFailoverClient returns a client of the units of an HA pair or sync group, given their iControl portal URLs.
+// The calls are sent to the active unit, found with System.Failover get_failover_state,
+// or to the first unit answering when none is active.
+//
+// A call failing with a connection error or a fault only raised by a standby unit is sent again to the
+// next unit, once per unit. Calls changing the configuration only fail over when the request could not have
+// reached the device, unless the context was marked with ContextWithRetrySafe.
+// Calls made in a session, see WithSession, never fail over as the session belongs to one unit.
+//
+// The unit serving a call is reported in the URL of the CallInfo and the CallEvent of the hooks
+// and the instrumentation, and in the EndpointError wrapping the errors of the calls.
+func NewFailoverClient(urls []string, opt ...Option) *Client {
+
+	g := &endpointGroup{active: -1}
+	for _, url := range urls {
+		g.members = append(g.members, NewClient(url, opt...))
+	}
+
+	c := &Client{group: g}
+	if len(g.members) > 0 {
+		c.opts = g.members[0].opts
+	} else {
+		opts := defaultOptions
+		c.opts = &opts
+	}
+
+	return c
+}
+
+// Endpoints returns the iControl portal URLs of the units of a failover client,
+// or the URL of the device of a client.
+func (c *Client) Endpoints() []string {
+	if c.group == nil {
+		return []string{c.url}
+	}
+
+	urls := make([]string, len(c.group.members))
+	for i, m := range c.group.members {
+		urls[i] = m.url
+	}
+	return urls
+}
+
+// endpointGroup routes the calls of a failover client to one of its members.
+type endpointGroup struct {
+	members []*Client
+
+	mu     sync.Mutex
+	active int // The index of the member serving the calls, -1 until one is found.
+}
+
+// url returns the URL of the member serving the calls, the first one until one is found.
+func (g *endpointGroup) url() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch {
+	case g.active >= 0:
+		return g.members[g.active].url
+	case len(g.members) > 0:
+		return g.members[0].url
+	}
+	return ""
+}
+
+// call sends the request to the serving member, failing over to the next ones.
+func (g *endpointGroup) call(ctx context.Context, session string, request interface{}) ([]byte, error) {
+
+	tried := make([]bool, len(g.members))
+	var last error
+	for {
+		i, err := g.pick(ctx, tried)
+		if err != nil {
+			if last != nil {
+				return nil, last
+			}
+			return nil, err
+		}
+
+		m := g.members[i]
+		if session != "" {
+			cp := *m
+			cp.session = session
+			m = &cp
+		}
+
+		response, err := m.Call(ctx, request)
+		if err == nil {
+			return response, nil
+		}
+		err = &EndpointError{URL: m.url, Err: err}
+		if session != "" || !g.failover(ctx, request, err) {
+			return nil, err
+		}
+
+		g.demote(i)
+		tried[i] = true
+		last = err
+	}
+}
+
+// failover reports whether a failed call is sent again to another member.
+func (g *endpointGroup) failover(ctx context.Context, request interface{}, err error) bool {
+
+	if ctx.Err() != nil {
+		return false
+	}
+	if isStandbyFault(err) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if !isConnectionError(err) {
+		return false
+	}
+
+	body, merr := xml.Marshal(request)
+	return merr == nil && (parseOperation(body).idempotent() || isRetrySafe(ctx))
+}
+
+// pick returns the index of the member serving the calls, probing the members not tried yet if none is known.
+func (g *endpointGroup) pick(ctx context.Context, tried []bool) (int, error) {
+
+	g.mu.Lock()
+	active := g.active
+	g.mu.Unlock()
+
+	if active >= 0 && !tried[active] {
+		return active, nil
+	}
+
+	healthy := -1
+	var last error
+	for i, m := range g.members {
+		if tried[i] {
+			continue
+		}
+
+		state, err := m.failoverState(ctx)
+		if err != nil {
+			last = &EndpointError{URL: m.url, Err: err}
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		if state == failoverStateActive {
+			healthy = i
+			break
+		}
+		if healthy < 0 {
+			healthy = i
+		}
+	}
+
+	if healthy < 0 {
+		if last != nil {
+			return -1, fmt.Errorf("%w: %v", ErrNoActiveEndpoint, last)
+		}
+		return -1, ErrNoActiveEndpoint
+	}
+
+	g.mu.Lock()
+	g.active = healthy
+	g.mu.Unlock()
+
+	return healthy, nil
+}
+
+// demote forgets the member i serving the calls after it failed.
+func (g *endpointGroup) demote(i int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.active == i {
+		g.active = -1
+	}
+}
+
+const failoverStateActive = "FAILOVER_STATE_ACTIVE"
+
+type getFailoverStateReq struct {
+	BaseEnvEnvelope
+	Body struct {
+		GetFailoverState struct{} `xml:"tns:get_failover_state"`
+	} `xml:"env:Body"`
+}
+
+type getFailoverStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		Response struct {
+			Return string `xml:"return"`
+		} `xml:"get_failover_stateResponse"`
+	} `xml:"Body"`
+}
+
+// failoverState returns the System.Failover state of the device, see the system/failover package.
+func (c *Client) failoverState(ctx context.Context) (string, error) {
+
+	bt, err := c.Call(ctx, getFailoverStateReq{BaseEnvEnvelope: NewBaseEnvEnvelope("urn:iControl:System/Failover")})
+	if err != nil {
+		return "", err
+	}
+
+	var resp getFailoverStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(resp.Body.Response.Return), nil
+}
+
+// isStandbyFault reports whether err is a fault raised because the device is not the active unit.
+func isStandbyFault(err error) bool {
+	f, ok := AsFault(err)
+	return ok && f.Exception == ExceptionOperationFailed && strings.Contains(strings.ToLower(f.ErrorString), "standby")
+}
+
+// isConnectionError reports whether err was raised without a response of the device.
+func isConnectionError(err error) bool {
+	if _, ok := AsFault(err); ok {
+		return false
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return false
+	}
+	return DefaultRetryable(err)
+}
@@ -0,0 +1,124 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+	"github.com/wule61/go-f5-soap/system"
+)
+
+func served(s *f5test.Server, op string) int {
+	n := 0
+	for _, c := range s.Calls() {
+		if c.Operation == op {
+			n++
+		}
+	}
+	return n
+}
+
+func TestFailoverClient(t *testing.T) {
+
+	unit1 := f5test.NewServer(t, f5test.WithFailoverState(system.FailoverStateStandby))
+	unit2 := f5test.NewServer(t)
+	for _, s := range []*f5test.Server{unit1, unit2} {
+		s.AddPools(f5test.Pool{Name: "/Common/pool1"})
+	}
+
+	var urls []string
+	c := soap.NewFailoverClient([]string{unit1.URL(), unit2.URL()},
+		soap.WithBasicAuth(f5test.DefaultUsername, f5test.DefaultPassword),
+		soap.WithHooks(soap.Hooks{
+			AfterResponse: func(ctx context.Context, info soap.CallInfo) {
+				if info.Operation == "get_ttl" {
+					urls = append(urls, info.URL)
+				}
+			},
+		}),
+	)
+	if got := c.Endpoints(); len(got) != 2 || got[0] != unit1.URL() {
+		t.Fatalf("unexpected endpoints %v", got)
+	}
+
+	// The calls are sent to the active unit.
+	p := pool.New(c)
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if c.URL() != unit2.URL() || served(unit2, "get_ttl") != 1 || served(unit1, "get_ttl") != 0 {
+		t.Fatalf("the call was not sent to the active unit %s", c.URL())
+	}
+
+	// The units swap roles: a configuration change fails over to the new active unit.
+	unit1.SetFailoverState(system.FailoverStateActive)
+	unit2.SetFailoverState(system.FailoverStateStandby)
+
+	if err := p.SetTTL([]string{"/Common/pool1"}, []int64{120}); err != nil {
+		t.Fatal(err)
+	}
+	if served(unit2, "set_ttl") != 1 || served(unit1, "set_ttl") != 1 || c.URL() != unit1.URL() {
+		t.Fatalf("the change did not fail over, served by %s", c.URL())
+	}
+	if got, _ := unit1.Pool("/Common/pool1", ""); got.TTL != 120 {
+		t.Fatalf("expected the change to be applied by the new active unit, got a ttl of %d", got.TTL)
+	}
+
+	// The new active unit answers with its own faults.
+	_, err := p.GetTTL([]string{"/Common/aaaa"})
+	var endpointErr *soap.EndpointError
+	if !soap.IsNotFound(err) || !errors.As(err, &endpointErr) || endpointErr.URL != unit1.URL() {
+		t.Fatalf("expected the error of the new active unit, got %v", err)
+	}
+
+	// The active unit goes down: the reads fail over to the healthy standby unit.
+	unit1.Close()
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if c.URL() != unit2.URL() {
+		t.Fatalf("expected the calls to be served by %s, got %s", unit2.URL(), c.URL())
+	}
+	if want := []string{unit2.URL(), unit2.URL()}; len(urls) != 2 || urls[0] != want[0] || urls[1] != want[1] {
+		t.Fatalf("expected %v, got %v", want, urls)
+	}
+
+	unit2.Close()
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); !errors.As(err, &endpointErr) || endpointErr.URL != unit2.URL() {
+		t.Fatalf("expected the error of the last unit, got %v", err)
+	}
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); !errors.Is(err, soap.ErrNoActiveEndpoint) {
+		t.Fatalf("expected ErrNoActiveEndpoint, got %v", err)
+	}
+}
+
+func TestFailoverClient_Session(t *testing.T) {
+
+	active := f5test.NewServer(t)
+	standby := f5test.NewServer(t, f5test.WithFailoverState(system.FailoverStateStandby))
+	for _, s := range []*f5test.Server{active, standby} {
+		s.AddPools(f5test.Pool{Name: "/Common/pool1"})
+	}
+
+	c := soap.NewFailoverClient([]string{active.URL(), standby.URL()}, soap.WithBasicAuth(f5test.DefaultUsername, f5test.DefaultPassword))
+	sc := c.WithSession(1)
+	if _, err := pool.New(sc).GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Calls made in a session never fail over, the session belonging to the unit which opened it.
+	active.Close()
+	if _, err := pool.New(sc).GetTTL([]string{"/Common/pool1"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := served(standby, "get_ttl"); n != 0 {
+		t.Fatalf("expected no call in the session on the standby unit, got %d", n)
+	}
+
+	if _, err := pool.New(c).GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,174 @@
+package soap
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Primary error codes reported by iControl in the primary_error_code field of a fault.
+const (
+	// ErrorCodeNotFound The requested object was not found.
+	ErrorCodeNotFound int64 = 0x01020036
+
+	// ErrorCodeAlreadyExists The requested object already exists.
+	ErrorCodeAlreadyExists int64 = 0x01020066
+)
+
+// Exception classes raised by iControl.
+const (
+	ExceptionAccessDenied    = "Common::AccessDenied"
+	ExceptionInvalidArgument = "Common::InvalidArgument"
+	ExceptionInvalidUser     = "Common::InvalidUser"
+	ExceptionNoSuchInterface = "Common::NoSuchInterface"
+	ExceptionNotImplemented  = "Common::NotImplemented"
+	ExceptionOperationFailed = "Common::OperationFailed"
+	ExceptionOutOfMemory     = "Common::OutOfMemory"
+)
+
+// Sentinel errors matched by Fault through errors.Is.
+var (
+	ErrNotFound        = errors.New("icontrol: object not found")
+	ErrAlreadyExists   = errors.New("icontrol: object already exists")
+	ErrAccessDenied    = errors.New("icontrol: access denied")
+	ErrInvalidArgument = errors.New("icontrol: invalid argument")
+)
+
+// Fault is the typed form of a SOAP fault returned by iControl.
+// It wraps the HTTPError the fault arrived in, so errors.As(err, *HTTPError) keeps working.
+type Fault struct {
+	FaultCode          string // The SOAP faultcode, e.g. SOAP-ENV:Server.
+	FaultString        string // The raw SOAP faultstring.
+	Interface          string // The iControl interface that raised the exception, e.g. urn:iControl:GlobalLB/Pool.
+	Operation          string // The operation that raised the exception, e.g. get_ttl.
+	Exception          string // The iControl exception class, e.g. Common::OperationFailed.
+	PrimaryErrorCode   int64  // The primary error code, e.g. 0x01020036.
+	SecondaryErrorCode int64  // The secondary error code.
+	ErrorString        string // The error string reported by the device.
+	Object             string // The name of the offending object, if the error string carries one.
+
+	err *HTTPError
+}
+
+func (f *Fault) Error() string {
+	if f.ErrorString != "" {
+		return fmt.Sprintf("%s: %s", f.Exception, f.ErrorString)
+	}
+	if f.Exception != "" {
+		return f.Exception
+	}
+	return fmt.Sprintf("%s: %s", f.FaultCode, f.FaultString)
+}
+
+// Unwrap returns the HTTPError the fault was decoded from.
+func (f *Fault) Unwrap() error {
+	if f.err == nil {
+		return nil
+	}
+	return f.err
+}
+
+// Is reports whether the fault matches one of the sentinel errors of this package.
+func (f *Fault) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return f.PrimaryErrorCode == ErrorCodeNotFound ||
+			strings.Contains(f.ErrorString, "was not found")
+	case ErrAlreadyExists:
+		return f.PrimaryErrorCode == ErrorCodeAlreadyExists ||
+			strings.Contains(f.ErrorString, "already exists")
+	case ErrAccessDenied:
+		return f.Exception == ExceptionAccessDenied || f.Exception == ExceptionInvalidUser
+	case ErrInvalidArgument:
+		return f.Exception == ExceptionInvalidArgument
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an iControl fault for a missing object.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsAlreadyExists reports whether err is an iControl fault for an object that already exists.
+func IsAlreadyExists(err error) bool {
+	return errors.Is(err, ErrAlreadyExists)
+}
+
+// IsAccessDenied reports whether err is an iControl fault for an unauthorized operation.
+func IsAccessDenied(err error) bool {
+	return errors.Is(err, ErrAccessDenied)
+}
+
+// IsInvalidArgument reports whether err is an iControl fault for an invalid argument.
+func IsInvalidArgument(err error) bool {
+	return errors.Is(err, ErrInvalidArgument)
+}
+
+// AsFault returns the Fault carried by err, if any.
+func AsFault(err error) (*Fault, bool) {
+	var f *Fault
+	if errors.As(err, &f) {
+		return f, true
+	}
+	return nil, false
+}
+
+type faultResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		Fault *struct {
+			FaultCode   string `xml:"faultcode"`
+			FaultString string `xml:"faultstring"`
+		} `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+var (
+	faultCaughtRe    = regexp.MustCompile(`Exception caught in [^:]*::(\S+)::(\w+)\(\)`)
+	faultExceptionRe = regexp.MustCompile(`(?m)^\s*Exception:\s*(\S+)`)
+	faultPrimaryRe   = regexp.MustCompile(`primary_error_code\s*:\s*(-?\d+)`)
+	faultSecondaryRe = regexp.MustCompile(`secondary_error_code\s*:\s*(-?\d+)`)
+	faultErrorRe     = regexp.MustCompile(`(?s)error_string\s*:\s*(.*)$`)
+	faultObjectRe    = regexp.MustCompile(`\(([^()]+)\)`)
+)
+
+// parseFault decodes the SOAP fault carried by an HTTP error response.
+// It returns nil when the body is not a SOAP fault.
+func parseFault(httpErr *HTTPError) *Fault {
+
+	var resp faultResp
+	if err := xml.Unmarshal(httpErr.ResponseBody, &resp); err != nil || resp.Body.Fault == nil {
+		return nil
+	}
+
+	f := &Fault{
+		FaultCode:   strings.TrimSpace(resp.Body.Fault.FaultCode),
+		FaultString: strings.TrimSpace(resp.Body.Fault.FaultString),
+		err:         httpErr,
+	}
+
+	if m := faultCaughtRe.FindStringSubmatch(f.FaultString); m != nil {
+		f.Interface, f.Operation = m[1], m[2]
+	}
+	if m := faultExceptionRe.FindStringSubmatch(f.FaultString); m != nil {
+		f.Exception = m[1]
+	}
+	if m := faultPrimaryRe.FindStringSubmatch(f.FaultString); m != nil {
+		f.PrimaryErrorCode, _ = strconv.ParseInt(m[1], 10, 64)
+	}
+	if m := faultSecondaryRe.FindStringSubmatch(f.FaultString); m != nil {
+		f.SecondaryErrorCode, _ = strconv.ParseInt(m[1], 10, 64)
+	}
+	if m := faultErrorRe.FindStringSubmatch(f.FaultString); m != nil {
+		f.ErrorString = strings.TrimSpace(m[1])
+	}
+	if m := faultObjectRe.FindStringSubmatch(f.ErrorString); m != nil {
+		f.Object = m[1]
+	}
+
+	return f
+}
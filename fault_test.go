@@ -0,0 +1,153 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+const notFoundFault = `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+<SOAP-ENV:Body>
+<SOAP-ENV:Fault>
+<faultcode xsi:type="xsd:QName">SOAP-ENV:Server</faultcode>
+<faultstring xsi:type="xsd:string">Exception caught in GlobalLB::urn:iControl:GlobalLB/Pool::get_ttl()
+Exception: Common::OperationFailed
+	primary_error_code   : 16908342 (0x01020036)
+	secondary_error_code : 0
+	error_string         : 01020036:3: The requested pool (/Common/pool1) was not found.</faultstring>
+</SOAP-ENV:Fault>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+const alreadyExistsFault = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+<SOAP-ENV:Body>
+<SOAP-ENV:Fault>
+<faultcode>SOAP-ENV:Server</faultcode>
+<faultstring>Exception caught in GlobalLB::urn:iControl:GlobalLB/Pool::create_v2()
+Exception: Common::OperationFailed
+	primary_error_code   : 16908390 (0x01020066)
+	secondary_error_code : 0
+	error_string         : 01020066:3: The requested GTM pool (/Common/pool1) already exists in partition Common.</faultstring>
+</SOAP-ENV:Fault>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+const accessDeniedFault = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+<SOAP-ENV:Body>
+<SOAP-ENV:Fault>
+<faultcode>SOAP-ENV:Server</faultcode>
+<faultstring>Exception caught in System::urn:iControl:System/SystemInfo::get_version()
+Exception: Common::AccessDenied
+	primary_error_code   : 0
+	secondary_error_code : 0
+	error_string         : Access Denied: User guest</faultstring>
+</SOAP-ENV:Fault>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+func newFaultServer(t *testing.T, status int, body string) *soap.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return soap.NewClient(srv.URL)
+}
+
+func TestFault_NotFound(t *testing.T) {
+
+	p := pool.New(newFaultServer(t, http.StatusInternalServerError, notFoundFault))
+
+	_, err := p.GetTTL([]string{"/Common/pool1"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if soap.IsAlreadyExists(err) {
+		t.Fatalf("unexpected already exists match for %v", err)
+	}
+
+	fault, ok := soap.AsFault(err)
+	if !ok {
+		t.Fatalf("expected *soap.Fault, got %T", err)
+	}
+
+	want := soap.Fault{
+		FaultCode:        "SOAP-ENV:Server",
+		Interface:        "urn:iControl:GlobalLB/Pool",
+		Operation:        "get_ttl",
+		Exception:        soap.ExceptionOperationFailed,
+		PrimaryErrorCode: soap.ErrorCodeNotFound,
+		ErrorString:      "01020036:3: The requested pool (/Common/pool1) was not found.",
+		Object:           "/Common/pool1",
+	}
+	if fault.FaultCode != want.FaultCode ||
+		fault.Interface != want.Interface ||
+		fault.Operation != want.Operation ||
+		fault.Exception != want.Exception ||
+		fault.PrimaryErrorCode != want.PrimaryErrorCode ||
+		fault.SecondaryErrorCode != want.SecondaryErrorCode ||
+		fault.ErrorString != want.ErrorString ||
+		fault.Object != want.Object {
+		t.Fatalf("got %+v, want %+v", fault, want)
+	}
+
+	var httpErr *soap.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected wrapped *soap.HTTPError, got %v", err)
+	}
+}
+
+func TestFault_AlreadyExists(t *testing.T) {
+
+	c := newFaultServer(t, http.StatusInternalServerError, alreadyExistsFault)
+
+	_, err := c.Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool"))
+	if !soap.IsAlreadyExists(err) {
+		t.Fatalf("expected already exists, got %v", err)
+	}
+
+	fault, _ := soap.AsFault(err)
+	if fault.Object != "/Common/pool1" || fault.Operation != "create_v2" {
+		t.Fatalf("unexpected fault %+v", fault)
+	}
+}
+
+func TestFault_AccessDenied(t *testing.T) {
+
+	c := newFaultServer(t, http.StatusInternalServerError, accessDeniedFault)
+
+	_, err := c.Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool"))
+	if !soap.IsAccessDenied(err) {
+		t.Fatalf("expected access denied, got %v", err)
+	}
+	if soap.IsNotFound(err) {
+		t.Fatalf("unexpected not found match for %v", err)
+	}
+}
+
+func TestFault_NotASoapFault(t *testing.T) {
+
+	c := newFaultServer(t, http.StatusServiceUnavailable, "<html>busy</html>")
+
+	_, err := c.Call(context.Background(), soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool"))
+
+	if _, ok := soap.AsFault(err); ok {
+		t.Fatalf("unexpected fault for %v", err)
+	}
+
+	var httpErr *soap.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected *soap.HTTPError, got %v", err)
+	}
+}
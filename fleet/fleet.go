@@ -0,0 +1,242 @@
+// Package fleet runs the same query on many BIG-IP devices concurrently,
+// e.g. the GlobalLB.Pool object status of every GTM of a region.
+//
+//	f := fleet.New(fleet.WithParallelism(8))
+//	f.Add("gtm-sh-1", soap.NewClient(url, opts...), fleet.Labels{"region": "sh"})
+//	res := f.Do(ctx, fleet.Labels{"region": "sh"}, func(ctx context.Context, d *fleet.Device) (interface{}, error) {
+//		return d.BigIP.GlobalLB.Pool.GetObjectStatusCtx(ctx, names)
+//	})
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/bigip"
+)
+
+// DefaultParallelism is the number of devices queried at once unless WithParallelism is used.
+const DefaultParallelism = 8
+
+// Labels are the labels of a device, e.g. region=sh, role=gtm.
+// As a selector, they match the devices having all of them, an empty selector matching every device.
+type Labels map[string]string
+
+// Matches reports whether labels has all the labels of the selector l.
+func (l Labels) Matches(labels Labels) bool {
+	for k, v := range l {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Device is a named device of a fleet.
+type Device struct {
+	Name   string
+	Labels Labels
+	Client *soap.Client
+	BigIP  *bigip.BigIP // The interfaces of the device, built on Client.
+}
+
+// Query is run on each selected device. The returned value is reported in the Result of the device.
+type Query func(ctx context.Context, d *Device) (interface{}, error)
+
+// Result is the outcome of a query on one device.
+type Result struct {
+	Device   string        // The name of the device.
+	Value    interface{}   // The value returned by the query.
+	Err      error         // The error returned by the query.
+	Duration time.Duration // The duration of the query.
+}
+
+// Results are the outcomes of a query, in the order the devices were added to the fleet.
+type Results []Result
+
+// Values returns the values of the devices where the query succeeded, by device name.
+func (r Results) Values() map[string]interface{} {
+	values := make(map[string]interface{}, len(r))
+	for _, res := range r {
+		if res.Err == nil {
+			values[res.Device] = res.Value
+		}
+	}
+	return values
+}
+
+// Err returns an *Error with the devices where the query failed, or nil.
+func (r Results) Err() error {
+	var errs map[string]error
+	for _, res := range r {
+		if res.Err == nil {
+			continue
+		}
+		if errs == nil {
+			errs = make(map[string]error)
+		}
+		errs[res.Device] = res.Err
+	}
+	if errs == nil {
+		return nil
+	}
+	return &Error{Errors: errs}
+}
+
+// Error reports the devices where a query failed.
+type Error struct {
+	Errors map[string]error // The errors by device name.
+}
+
+func (e *Error) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = name + ": " + e.Errors[name].Error()
+	}
+	return fmt.Sprintf("fleet: query failed on %d device(s): %s", len(names), strings.Join(msgs, "; "))
+}
+
+type Option func(*Fleet)
+
+// WithParallelism sets the number of devices queried at once.
+func WithParallelism(n int) Option {
+	return func(f *Fleet) {
+		if n > 0 {
+			f.parallelism = n
+		}
+	}
+}
+
+// Fleet holds named device clients.
+type Fleet struct {
+	parallelism int
+
+	mu      sync.RWMutex
+	devices []*Device
+}
+
+func New(opts ...Option) *Fleet {
+	f := &Fleet{parallelism: DefaultParallelism}
+	for _, o := range opts {
+		o(f)
+	}
+	return f
+}
+
+// Add adds the device name reached with c. The names are unique in a fleet.
+func (f *Fleet) Add(name string, c *soap.Client, labels Labels) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, d := range f.devices {
+		if d.Name == name {
+			return fmt.Errorf("fleet: device %s already exists", name)
+		}
+	}
+
+	f.devices = append(f.devices, &Device{Name: name, Labels: labels, Client: c, BigIP: bigip.New(c)})
+	return nil
+}
+
+// Remove removes the device name, if present.
+func (f *Fleet) Remove(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, d := range f.devices {
+		if d.Name == name {
+			f.devices = append(f.devices[:i:i], f.devices[i+1:]...)
+			return
+		}
+	}
+}
+
+// Device returns the device name.
+func (f *Fleet) Device(name string) (*Device, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, d := range f.devices {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// Select returns the devices matching selector, in the order they were added.
+func (f *Fleet) Select(selector Labels) []*Device {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var devices []*Device
+	for _, d := range f.devices {
+		if selector.Matches(d.Labels) {
+			devices = append(devices, d)
+		}
+	}
+	return devices
+}
+
+// Do runs q on the devices matching selector concurrently, at most the parallelism of the fleet at once,
+// and returns their results. The devices not queried yet when ctx ends report its error.
+// A panicking query is reported as the error of its device.
+func (f *Fleet) Do(ctx context.Context, selector Labels, q Query) Results {
+
+	devices := f.Select(selector)
+	results := make(Results, len(devices))
+
+	sem := make(chan struct{}, f.parallelism)
+	var wg sync.WaitGroup
+	for i, d := range devices {
+		results[i].Device = d.Name
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(res *Result, d *Device) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			res.Value, res.Err = run(ctx, d, q)
+			res.Duration = time.Since(start)
+		}(&results[i], d)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// run runs q on d, turning a panic into an error.
+func run(ctx context.Context, d *Device, q Query) (v interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			buf := make([]byte, 64<<10)
+			buf = buf[:runtime.Stack(buf, false)]
+			err = fmt.Errorf("fleet: query panicked on %s: %v\n%s", d.Name, r, buf)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return q(ctx, d)
+}
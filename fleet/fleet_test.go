@@ -0,0 +1,135 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+)
+
+func newFleet(t *testing.T, opts ...Option) *Fleet {
+
+	f := New(opts...)
+	for _, d := range []struct {
+		name   string
+		labels Labels
+		pool   string
+	}{
+		{"gtm-sh-1", Labels{"region": "sh", "role": "gtm"}, "/Common/pool1"},
+		{"gtm-sh-2", Labels{"region": "sh", "role": "gtm"}, "/Common/pool1"},
+		{"gtm-bj-1", Labels{"region": "bj", "role": "gtm"}, "/Common/pool2"},
+	} {
+		s := f5test.NewServer(t)
+		s.AddPools(f5test.Pool{Name: d.pool})
+		if err := f.Add(d.name, s.Client(), d.labels); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return f
+}
+
+func TestFleet_Do(t *testing.T) {
+
+	f := newFleet(t)
+
+	res := f.Do(context.Background(), nil, func(ctx context.Context, d *Device) (interface{}, error) {
+		return d.BigIP.GlobalLB.Pool.GetObjectStatusCtx(ctx, []string{"/Common/pool1"})
+	})
+
+	if len(res) != 3 || res[0].Device != "gtm-sh-1" || res[2].Device != "gtm-bj-1" {
+		t.Fatalf("unexpected results %+v", res)
+	}
+
+	values := res.Values()
+	if len(values) != 2 {
+		t.Fatalf("expected 2 values, got %v", values)
+	}
+	if status := values["gtm-sh-2"].([]common.ObjectStatus); len(status) != 1 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	var ferr *Error
+	if err := res.Err(); !errors.As(err, &ferr) || len(ferr.Errors) != 1 || !soap.IsNotFound(ferr.Errors["gtm-bj-1"]) {
+		t.Fatalf("expected a not found error on gtm-bj-1, got %v", err)
+	}
+}
+
+func TestFleet_Select(t *testing.T) {
+
+	f := newFleet(t)
+
+	var names []string
+	for _, d := range f.Select(Labels{"region": "sh"}) {
+		names = append(names, d.Name)
+	}
+	if want := []string{"gtm-sh-1", "gtm-sh-2"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+
+	res := f.Do(context.Background(), Labels{"region": "bj"}, func(ctx context.Context, d *Device) (interface{}, error) {
+		return d.BigIP.GlobalLB.Pool.GetTTLCtx(ctx, []string{"/Common/pool2"})
+	})
+	if res.Err() != nil || len(res) != 1 || !reflect.DeepEqual(res[0].Value, []int64{30}) {
+		t.Fatalf("unexpected results %+v", res)
+	}
+
+	if err := f.Add("gtm-bj-1", nil, nil); err == nil {
+		t.Fatal("expected a duplicate device error")
+	}
+	f.Remove("gtm-bj-1")
+	if _, ok := f.Device("gtm-bj-1"); ok {
+		t.Fatal("the device was not removed")
+	}
+}
+
+func TestFleet_Parallelism(t *testing.T) {
+
+	f := newFleet(t, WithParallelism(2))
+
+	var mu sync.Mutex
+	inFlight, max := 0, 0
+	res := f.Do(context.Background(), nil, func(ctx context.Context, d *Device) (interface{}, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > max {
+			max = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		if d.Name == "gtm-sh-2" {
+			panic("boom")
+		}
+		return nil, nil
+	})
+
+	if max != 2 {
+		t.Fatalf("expected 2 concurrent queries, got %d", max)
+	}
+	if res[1].Err == nil || res[0].Err != nil || res[2].Err != nil {
+		t.Fatalf("expected the panic to be reported on gtm-sh-2, got %+v", res)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res = f.Do(ctx, nil, func(ctx context.Context, d *Device) (interface{}, error) {
+		return nil, nil
+	})
+	for _, r := range res {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %+v", res)
+		}
+	}
+}
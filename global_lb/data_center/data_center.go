@@ -18,15 +18,17 @@ const tns = "urn:iControl:GlobalLB/DataCenter"
 // remove a server from a data center, and so on.
 type IDataCenter interface {
 	GetList() ([]string, error)
+	GetListCtx(ctx context.Context) ([]string, error)
 	GetServer(dataCenters []string) ([]DataCenterServerDefinition, error)
+	GetServerCtx(ctx context.Context, dataCenters []string) ([]DataCenterServerDefinition, error)
 }
 
 // DataCenterServerDefinition
 // Introduced : BIG-IP_v9.2.0
 // A struct that contains definition for the data center and the associated servers.
 type DataCenterServerDefinition struct {
-	DataCenter string   // The name that identifies a data center.
-	Servers    []string // The servers in the data center.
+	DataCenter string   `json:"data_center" yaml:"data_center"`             // The name that identifies a data center.
+	Servers    []string `json:"servers,omitempty" yaml:"servers,omitempty"` // The servers in the data center.
 }
 
 var _ IDataCenter = (*Client)(nil)
@@ -60,8 +62,13 @@ type getListResp struct {
 }
 
 func (d *Client) GetList() ([]string, error) {
+	return d.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (d *Client) GetListCtx(ctx context.Context) ([]string, error) {
 
-	bt, err := d.c.Call(context.Background(), getListReq{
+	bt, err := d.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{GetList: struct{}{}},
 	})
@@ -116,8 +123,13 @@ type getServerResp struct {
 // Introduced : BIG-IP_v9.2.0
 // Gets a list of servers of the specified data centers.
 func (d *Client) GetServer(dataCenters []string) ([]DataCenterServerDefinition, error) {
+	return d.GetServerCtx(context.Background(), dataCenters)
+}
+
+// GetServerCtx is the context-aware variant of GetServer.
+func (d *Client) GetServerCtx(ctx context.Context, dataCenters []string) ([]DataCenterServerDefinition, error) {
 
-	bt, err := d.c.Call(context.Background(), getServerReq{
+	bt, err := d.c.Call(ctx, getServerReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getServerBody{GetServer: getServer{DataCenters: DataCenters{dataCenters}}},
 	})
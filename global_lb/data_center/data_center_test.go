@@ -1,17 +1,22 @@
 package data_center
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddDataCenters(
+		f5test.DataCenter{Name: "/Common/SH", Servers: []string{"/Common/bigip1", "/Common/bigip2"}},
+		f5test.DataCenter{Name: "/Common/BJ"},
 	)
+
+	return s.Client()
 }
 
 func TestDataCenter_GetList(t *testing.T) {
@@ -23,16 +28,28 @@ func TestDataCenter_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []string{"/Common/SH", "/Common/BJ"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestDataCenter_GetServer(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetServer([]string{"/Common/SH", "/Common/JD", "/Common/BJ"})
+	arr, err := p.GetServer([]string{"/Common/SH", "/Common/BJ"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []DataCenterServerDefinition{
+		{DataCenter: "/Common/SH", Servers: []string{"/Common/bigip1", "/Common/bigip2"}},
+		{DataCenter: "/Common/BJ"},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if _, err := p.GetServer([]string{"/Common/JD"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
@@ -1,6 +1,10 @@
 package global_lb
 
-import "github.com/wule61/go-f5-soap/common"
+import (
+	"fmt"
+
+	"github.com/wule61/go-f5-soap/common"
+)
 
 // RegionType
 // Introduced : BIG-IP_v9.2.0
@@ -28,17 +32,102 @@ const (
 	RegionTypeGEOIPISP RegionType = "REGION_TYPE_GEOIP_ISP"
 )
 
+// regionTypes are the values of RegionType.
+var regionTypes = common.NewEnum("RegionType", "REGION_TYPE_", "BIG-IP_v9.2.0",
+	RegionTypeCIDR,
+	RegionTypeRegion,
+	RegionTypeContinent,
+	RegionTypeCountry,
+	RegionTypeState,
+	RegionTypePool,
+	RegionTypeDataCenter,
+	RegionTypeISPRegion,
+	RegionTypeGEOIPISP,
+)
+
+// ParseRegionType returns the RegionType whose short or iControl form is s, e.g. continent or REGION_TYPE_CONTINENT.
+func ParseRegionType(s string) (RegionType, error) {
+	return regionTypes.Parse(s)
+}
+
+// RegionTypeValues returns the values of RegionType.
+func RegionTypeValues() []RegionType {
+	return regionTypes.Values()
+}
+
+// IsValid reports whether r is one of the RegionType values.
+func (r RegionType) IsValid() bool {
+	return regionTypes.IsValid(r)
+}
+
+// String returns the iControl value of r.
+func (r RegionType) String() string {
+	return string(r)
+}
+
+// Short returns the short form of r, e.g. continent.
+func (r RegionType) Short() string {
+	return regionTypes.Short(r)
+}
+
+// Introduced returns the BIG-IP version which introduced RegionType.
+func (r RegionType) Introduced() string {
+	return regionTypes.Introduced()
+}
+
+func (r RegionType) MarshalJSON() ([]byte, error) {
+	return regionTypes.EncodeJSON(r)
+}
+
+func (r *RegionType) UnmarshalJSON(b []byte) error {
+	return regionTypes.DecodeJSON(b, r)
+}
+
+func (r RegionType) MarshalYAML() (interface{}, error) {
+	return regionTypes.EncodeYAML(r)
+}
+
+func (r *RegionType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return regionTypes.DecodeYAML(unmarshal, r)
+}
+
 type VirtualServerDefinition struct {
-	Name    string `xml:"name"`
-	Address string `xml:"address"`
-	Port    int64  `xml:"port"`
+	Name    string `xml:"name" json:"name" yaml:"name"`
+	Address string `xml:"address" json:"address" yaml:"address"`
+	Port    int64  `xml:"port" json:"port" yaml:"port"`
+}
+
+// IPPort returns the IP:port of the virtual server.
+func (d VirtualServerDefinition) IPPort() common.IPPortDefinition {
+	return common.IPPortDefinition{Address: d.Address, Port: d.Port}
+}
+
+// ValidateDefinitions checks the addresses of defs before they are sent to the device.
+func ValidateDefinitions(defs []VirtualServerDefinition) error {
+	for i, d := range defs {
+		if err := d.IPPort().Validate(); err != nil {
+			return fmt.Errorf("virtual server %d (%s): %w", i, d.Name, err)
+		}
+	}
+	return nil
 }
 
 type VirtualServerID struct {
-	Name   string `xml:"name"`
-	Server string `xml:"server"`
+	Name   string `xml:"name" json:"name" yaml:"name"`
+	Server string `xml:"server" json:"server" yaml:"server"`
+}
+
+// PoolMemberDefinition
+// Introduced : BIG-IP_v9.2.0
+// A struct that describes a pool member, the virtual server listening on an IP:port and its order in the pool.
+type PoolMemberDefinition struct {
+	Member common.IPPortDefinition `xml:"member" json:"member" yaml:"member"`
+	Order  int64                   `xml:"order" json:"order" yaml:"order"`
 }
 
+// MonitorRuleType
+// Introduced : BIG-IP_v9.2.0
+// A list of monitor rule types.
 type MonitorRuleType string
 
 const (
@@ -58,10 +147,65 @@ const (
 	MonitorRuleTypeMOfN MonitorRuleType = "MONITOR_RULE_TYPE_M_OF_N"
 )
 
+// monitorRuleTypes are the values of MonitorRuleType.
+var monitorRuleTypes = common.NewEnum("MonitorRuleType", "MONITOR_RULE_TYPE_", "BIG-IP_v9.2.0",
+	MonitorRuleTypeUndefined,
+	MonitorRuleTypeNone,
+	MonitorRuleTypeSingle,
+	MonitorRuleTypeAndList,
+	MonitorRuleTypeMOfN,
+)
+
+// ParseMonitorRuleType returns the MonitorRuleType whose short or iControl form is s, e.g. single or MONITOR_RULE_TYPE_SINGLE.
+func ParseMonitorRuleType(s string) (MonitorRuleType, error) {
+	return monitorRuleTypes.Parse(s)
+}
+
+// MonitorRuleTypeValues returns the values of MonitorRuleType.
+func MonitorRuleTypeValues() []MonitorRuleType {
+	return monitorRuleTypes.Values()
+}
+
+// IsValid reports whether m is one of the MonitorRuleType values.
+func (m MonitorRuleType) IsValid() bool {
+	return monitorRuleTypes.IsValid(m)
+}
+
+// String returns the iControl value of m.
+func (m MonitorRuleType) String() string {
+	return string(m)
+}
+
+// Short returns the short form of m, e.g. single.
+func (m MonitorRuleType) Short() string {
+	return monitorRuleTypes.Short(m)
+}
+
+// Introduced returns the BIG-IP version which introduced MonitorRuleType.
+func (m MonitorRuleType) Introduced() string {
+	return monitorRuleTypes.Introduced()
+}
+
+func (m MonitorRuleType) MarshalJSON() ([]byte, error) {
+	return monitorRuleTypes.EncodeJSON(m)
+}
+
+func (m *MonitorRuleType) UnmarshalJSON(b []byte) error {
+	return monitorRuleTypes.DecodeJSON(b, m)
+}
+
+func (m MonitorRuleType) MarshalYAML() (interface{}, error) {
+	return monitorRuleTypes.EncodeYAML(m)
+}
+
+func (m *MonitorRuleType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return monitorRuleTypes.DecodeYAML(unmarshal, m)
+}
+
 type MonitorRule struct {
-	Type             MonitorRuleType `xml:"type"`
-	Quorum           int64           `xml:"quorum"`
-	MonitorTemplates []string        `xml:"monitor_templates"`
+	Type             MonitorRuleType `xml:"type" json:"type" yaml:"type"`
+	Quorum           int64           `xml:"quorum" json:"quorum" yaml:"quorum"`
+	MonitorTemplates []string        `xml:"monitor_templates>item" json:"monitor_templates,omitempty" yaml:"monitor_templates,omitempty"`
 }
 
 // RegionDBType
@@ -78,6 +222,59 @@ const (
 	RegionDBTypeISP RegionDBType = "REGION_DB_TYPE_ISP"
 )
 
+// regionDBTypes are the values of RegionDBType.
+var regionDBTypes = common.NewEnum("RegionDBType", "REGION_DB_TYPE_", "BIG-IP_v9.2.0",
+	RegionDBTypeUserDefined,
+	RegionDBTypeACL,
+	RegionDBTypeISP,
+)
+
+// ParseRegionDBType returns the RegionDBType whose short or iControl form is s, e.g. isp or REGION_DB_TYPE_ISP.
+func ParseRegionDBType(s string) (RegionDBType, error) {
+	return regionDBTypes.Parse(s)
+}
+
+// RegionDBTypeValues returns the values of RegionDBType.
+func RegionDBTypeValues() []RegionDBType {
+	return regionDBTypes.Values()
+}
+
+// IsValid reports whether r is one of the RegionDBType values.
+func (r RegionDBType) IsValid() bool {
+	return regionDBTypes.IsValid(r)
+}
+
+// String returns the iControl value of r.
+func (r RegionDBType) String() string {
+	return string(r)
+}
+
+// Short returns the short form of r, e.g. isp.
+func (r RegionDBType) Short() string {
+	return regionDBTypes.Short(r)
+}
+
+// Introduced returns the BIG-IP version which introduced RegionDBType.
+func (r RegionDBType) Introduced() string {
+	return regionDBTypes.Introduced()
+}
+
+func (r RegionDBType) MarshalJSON() ([]byte, error) {
+	return regionDBTypes.EncodeJSON(r)
+}
+
+func (r *RegionDBType) UnmarshalJSON(b []byte) error {
+	return regionDBTypes.DecodeJSON(b, r)
+}
+
+func (r RegionDBType) MarshalYAML() (interface{}, error) {
+	return regionDBTypes.EncodeYAML(r)
+}
+
+func (r *RegionDBType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return regionDBTypes.DecodeYAML(unmarshal, r)
+}
+
 // LBMethod
 // Introduced : BIG-IP_v9.2.0
 // A list of load balancing modes.
@@ -145,12 +342,82 @@ const (
 	LBMethodVSScore LBMethod = "LB_METHOD_VS_SCORE"
 )
 
+// lbMethods are the values of LBMethod.
+var lbMethods = common.NewEnum("LBMethod", "LB_METHOD_", "BIG-IP_v9.2.0",
+	LBMethodReturnToDNS,
+	LBMethodNULL,
+	LBMethodRoundRobin,
+	LBMethodRatio,
+	LBMethodTopology,
+	LBMethodStaticPersist,
+	LBMethodGlobalAvailability,
+	LBMethodVSCapacity,
+	LBMethodLeastConn,
+	LBMethodLowestRTT,
+	LBMethodLowestHops,
+	LBMethodPacketRate,
+	LBMethodCPU,
+	LBMethodHitRatio,
+	LBMethodQOS,
+	LBMethodBPS,
+	LBMethodDropPacket,
+	LBMethodExplicitIP,
+	LBMethodConnectionRate,
+	LBMethodVSScore,
+)
+
+// ParseLBMethod returns the LBMethod whose short or iControl form is s, e.g. round-robin or LB_METHOD_ROUND_ROBIN.
+func ParseLBMethod(s string) (LBMethod, error) {
+	return lbMethods.Parse(s)
+}
+
+// LBMethodValues returns the values of LBMethod.
+func LBMethodValues() []LBMethod {
+	return lbMethods.Values()
+}
+
+// IsValid reports whether l is one of the LBMethod values.
+func (l LBMethod) IsValid() bool {
+	return lbMethods.IsValid(l)
+}
+
+// String returns the iControl value of l.
+func (l LBMethod) String() string {
+	return string(l)
+}
+
+// Short returns the short form of l, e.g. round-robin.
+func (l LBMethod) Short() string {
+	return lbMethods.Short(l)
+}
+
+// Introduced returns the BIG-IP version which introduced LBMethod.
+func (l LBMethod) Introduced() string {
+	return lbMethods.Introduced()
+}
+
+func (l LBMethod) MarshalJSON() ([]byte, error) {
+	return lbMethods.EncodeJSON(l)
+}
+
+func (l *LBMethod) UnmarshalJSON(b []byte) error {
+	return lbMethods.DecodeJSON(b, l)
+}
+
+func (l LBMethod) MarshalYAML() (interface{}, error) {
+	return lbMethods.EncodeYAML(l)
+}
+
+func (l *LBMethod) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return lbMethods.DecodeYAML(unmarshal, l)
+}
+
 // WideIPID
 // Introduced : BIG-IP_v12.0.0
 // A struct that uniquely identifies a wide IP.
 type WideIPID struct {
-	WideIPName string       `xml:"wideip_name"` // The name of the wide IP.
-	WideIPType GTMQueryType `xml:"wideip_type"` // The type of wide IP.
+	WideIPName string       `xml:"wideip_name" json:"wideip_name" yaml:"wideip_name"` // The name of the wide IP.
+	WideIPType GTMQueryType `xml:"wideip_type" json:"wideip_type" yaml:"wideip_type"` // The type of wide IP.
 }
 
 // GTMQueryType
@@ -181,24 +448,84 @@ const (
 	GtmQueryTypeNAPTR GTMQueryType = "GTM_QUERY_TYPE_NAPTR"
 )
 
+// gtmQueryTypes are the values of GTMQueryType.
+var gtmQueryTypes = common.NewEnum("GTMQueryType", "GTM_QUERY_TYPE_", "BIG-IP_v12.0.0",
+	GtmQueryTypeUnknown,
+	GtmQueryTypeA,
+	GtmQueryTypeCname,
+	GtmQueryTypeMX,
+	GtmQueryTypeAAAA,
+	GtmQueryTypeSRV,
+	GtmQueryTypeNAPTR,
+)
+
+// ParseGTMQueryType returns the GTMQueryType whose short or iControl form is s, e.g. cname or GTM_QUERY_TYPE_CNAME.
+func ParseGTMQueryType(s string) (GTMQueryType, error) {
+	return gtmQueryTypes.Parse(s)
+}
+
+// GTMQueryTypeValues returns the values of GTMQueryType.
+func GTMQueryTypeValues() []GTMQueryType {
+	return gtmQueryTypes.Values()
+}
+
+// IsValid reports whether g is one of the GTMQueryType values.
+func (g GTMQueryType) IsValid() bool {
+	return gtmQueryTypes.IsValid(g)
+}
+
+// String returns the iControl value of g.
+func (g GTMQueryType) String() string {
+	return string(g)
+}
+
+// Short returns the short form of g, e.g. cname.
+func (g GTMQueryType) Short() string {
+	return gtmQueryTypes.Short(g)
+}
+
+// Introduced returns the BIG-IP version which introduced GTMQueryType.
+func (g GTMQueryType) Introduced() string {
+	return gtmQueryTypes.Introduced()
+}
+
+func (g GTMQueryType) MarshalJSON() ([]byte, error) {
+	return gtmQueryTypes.EncodeJSON(g)
+}
+
+func (g *GTMQueryType) UnmarshalJSON(b []byte) error {
+	return gtmQueryTypes.DecodeJSON(b, g)
+}
+
+func (g GTMQueryType) MarshalYAML() (interface{}, error) {
+	return gtmQueryTypes.EncodeYAML(g)
+}
+
+func (g *GTMQueryType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return gtmQueryTypes.DecodeYAML(unmarshal, g)
+}
+
 // PoolID
 // Introduced : BIG-IP_v12.0.0
 // A struct that uniquely identifies a GTM pool.
 type PoolID struct {
-	PoolName string       `xml:"pool_name"` // The name of the pool.
-	PoolType GTMQueryType `xml:"pool_type"` // The type of pool.
+	PoolName string       `xml:"pool_name" json:"pool_name" yaml:"pool_name"` // The name of the pool.
+	PoolType GTMQueryType `xml:"pool_type" json:"pool_type" yaml:"pool_type"` // The type of pool.
 }
 
 type MonitorInstance struct {
-	TemplateName       string        // The monitor template used by this instance.
-	InstanceDefinition MonitorIPPort // The IP:port of this instance.
+	TemplateName       string        `json:"template_name" yaml:"template_name"`             // The monitor template used by this instance.
+	InstanceDefinition MonitorIPPort `json:"instance_definition" yaml:"instance_definition"` // The IP:port of this instance.
 }
 
 type MonitorIPPort struct {
-	AddressType AddressType             // The address type of the IP:port specified in ipport.
-	IPPort      common.IPPortDefinition // The IP:port definition.
+	AddressType AddressType             `json:"address_type" yaml:"address_type"` // The address type of the IP:port specified in ipport.
+	IPPort      common.IPPortDefinition `json:"ipport" yaml:"ipport"`             // The IP:port definition.
 }
 
+// AddressType
+// Introduced : BIG-IP_v9.2.0
+// A list of address types of monitor instances.
 type AddressType string
 
 const (
@@ -221,10 +548,66 @@ const (
 	ATypeExplicitAddress AddressType = "ATYPE_EXPLICIT_ADDRESS"
 )
 
+// addressTypes are the values of AddressType.
+var addressTypes = common.NewEnum("AddressType", "ATYPE_", "BIG-IP_v9.2.0",
+	ATypeUnset,
+	ATypeStarAddressStarPort,
+	ATypeStarAddressExplicitPort,
+	ATypeExplicitAddressExplicitPort,
+	ATypeStarAddress,
+	ATypeExplicitAddress,
+)
+
+// ParseAddressType returns the AddressType whose short or iControl form is s, e.g. star-address-explicit-port or ATYPE_STAR_ADDRESS_EXPLICIT_PORT.
+func ParseAddressType(s string) (AddressType, error) {
+	return addressTypes.Parse(s)
+}
+
+// AddressTypeValues returns the values of AddressType.
+func AddressTypeValues() []AddressType {
+	return addressTypes.Values()
+}
+
+// IsValid reports whether a is one of the AddressType values.
+func (a AddressType) IsValid() bool {
+	return addressTypes.IsValid(a)
+}
+
+// String returns the iControl value of a.
+func (a AddressType) String() string {
+	return string(a)
+}
+
+// Short returns the short form of a, e.g. star-address-explicit-port.
+func (a AddressType) Short() string {
+	return addressTypes.Short(a)
+}
+
+// Introduced returns the BIG-IP version which introduced AddressType.
+func (a AddressType) Introduced() string {
+	return addressTypes.Introduced()
+}
+
+func (a AddressType) MarshalJSON() ([]byte, error) {
+	return addressTypes.EncodeJSON(a)
+}
+
+func (a *AddressType) UnmarshalJSON(b []byte) error {
+	return addressTypes.DecodeJSON(b, a)
+}
+
+func (a AddressType) MarshalYAML() (interface{}, error) {
+	return addressTypes.EncodeYAML(a)
+}
+
+func (a *AddressType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return addressTypes.DecodeYAML(unmarshal, a)
+}
+
 type MonitorInstanceState struct {
-	Instance      MonitorInstance          // The monitor instance definition.
-	InstanceState MonitorInstanceStateType // The state of the monitor instance.
-	EnabledState  bool                     // The state indicating whether the instance is enabled/disabled.
+	Instance      MonitorInstance          `json:"instance" yaml:"instance"`             // The monitor instance definition.
+	InstanceState MonitorInstanceStateType `json:"instance_state" yaml:"instance_state"` // The state of the monitor instance.
+	EnabledState  bool                     `json:"enabled_state" yaml:"enabled_state"`   // The state indicating whether the instance is enabled/disabled.
 }
 
 // MonitorInstanceStateType
@@ -257,3 +640,202 @@ const (
 	// InstanceStateDownWaitForManualResume The instance state is DOWN, and should only be marked up manually.
 	InstanceStateDownWaitForManualResume MonitorInstanceStateType = "INSTANCE_STATE_DOWN_WAIT_FOR_MANUAL_RESUME"
 )
+
+// monitorInstanceStateTypes are the values of MonitorInstanceStateType.
+var monitorInstanceStateTypes = common.NewEnum("MonitorInstanceStateType", "INSTANCE_STATE_", "BIG-IP_v9.2.0",
+	InstanceStateUnchecked,
+	InstanceStateChecking,
+	InstanceStateUp,
+	InstanceStateDown,
+	InstanceStateForcedDown,
+	InstanceStateDisabled,
+	InstanceStateDownByIRULE,
+	InstanceStateDownWaitForManualResume,
+)
+
+// ParseMonitorInstanceStateType returns the MonitorInstanceStateType whose short or iControl form is s, e.g. up or INSTANCE_STATE_UP.
+func ParseMonitorInstanceStateType(s string) (MonitorInstanceStateType, error) {
+	return monitorInstanceStateTypes.Parse(s)
+}
+
+// MonitorInstanceStateTypeValues returns the values of MonitorInstanceStateType.
+func MonitorInstanceStateTypeValues() []MonitorInstanceStateType {
+	return monitorInstanceStateTypes.Values()
+}
+
+// IsValid reports whether m is one of the MonitorInstanceStateType values.
+func (m MonitorInstanceStateType) IsValid() bool {
+	return monitorInstanceStateTypes.IsValid(m)
+}
+
+// String returns the iControl value of m.
+func (m MonitorInstanceStateType) String() string {
+	return string(m)
+}
+
+// Short returns the short form of m, e.g. up.
+func (m MonitorInstanceStateType) Short() string {
+	return monitorInstanceStateTypes.Short(m)
+}
+
+// Introduced returns the BIG-IP version which introduced MonitorInstanceStateType.
+func (m MonitorInstanceStateType) Introduced() string {
+	return monitorInstanceStateTypes.Introduced()
+}
+
+func (m MonitorInstanceStateType) MarshalJSON() ([]byte, error) {
+	return monitorInstanceStateTypes.EncodeJSON(m)
+}
+
+func (m *MonitorInstanceStateType) UnmarshalJSON(b []byte) error {
+	return monitorInstanceStateTypes.DecodeJSON(b, m)
+}
+
+func (m MonitorInstanceStateType) MarshalYAML() (interface{}, error) {
+	return monitorInstanceStateTypes.EncodeYAML(m)
+}
+
+func (m *MonitorInstanceStateType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return monitorInstanceStateTypes.DecodeYAML(unmarshal, m)
+}
+
+// MetricLimitType
+// Introduced : BIG-IP_v9.2.0
+// A list of metric limit types.
+type MetricLimitType string
+
+const (
+	// MetricLimitCPUUsage The CPU usage limit, in percent.
+	MetricLimitCPUUsage MetricLimitType = "METRIC_LIMIT_CPU_USAGE"
+
+	// MetricLimitMemoryAvailable The available memory limit, in bytes.
+	MetricLimitMemoryAvailable MetricLimitType = "METRIC_LIMIT_MEMORY_AVAILABLE"
+
+	// MetricLimitBitsPerSecond The throughput limit, in bits per second.
+	MetricLimitBitsPerSecond MetricLimitType = "METRIC_LIMIT_BITS_PER_SECOND"
+
+	// MetricLimitPacketsPerSecond The packet rate limit, in packets per second.
+	MetricLimitPacketsPerSecond MetricLimitType = "METRIC_LIMIT_PACKETS_PER_SECOND"
+
+	// MetricLimitCurrentConnections The limit of concurrent connections.
+	MetricLimitCurrentConnections MetricLimitType = "METRIC_LIMIT_CURRENT_CONNECTIONS"
+
+	// MetricLimitKilobitsPerSecond The throughput limit, in kilobits per second.
+	MetricLimitKilobitsPerSecond MetricLimitType = "METRIC_LIMIT_KILOBITS_PER_SECOND"
+)
+
+// metricLimitTypes are the values of MetricLimitType.
+var metricLimitTypes = common.NewEnum("MetricLimitType", "METRIC_LIMIT_", "BIG-IP_v9.2.0",
+	MetricLimitCPUUsage,
+	MetricLimitMemoryAvailable,
+	MetricLimitBitsPerSecond,
+	MetricLimitPacketsPerSecond,
+	MetricLimitCurrentConnections,
+	MetricLimitKilobitsPerSecond,
+)
+
+// ParseMetricLimitType returns the MetricLimitType whose short or iControl form is s, e.g. bits-per-second or METRIC_LIMIT_BITS_PER_SECOND.
+func ParseMetricLimitType(s string) (MetricLimitType, error) {
+	return metricLimitTypes.Parse(s)
+}
+
+// MetricLimitTypeValues returns the values of MetricLimitType.
+func MetricLimitTypeValues() []MetricLimitType {
+	return metricLimitTypes.Values()
+}
+
+// IsValid reports whether m is one of the MetricLimitType values.
+func (m MetricLimitType) IsValid() bool {
+	return metricLimitTypes.IsValid(m)
+}
+
+// String returns the iControl value of m.
+func (m MetricLimitType) String() string {
+	return string(m)
+}
+
+// Short returns the short form of m, e.g. bits-per-second.
+func (m MetricLimitType) Short() string {
+	return metricLimitTypes.Short(m)
+}
+
+// Introduced returns the BIG-IP version which introduced MetricLimitType.
+func (m MetricLimitType) Introduced() string {
+	return metricLimitTypes.Introduced()
+}
+
+func (m MetricLimitType) MarshalJSON() ([]byte, error) {
+	return metricLimitTypes.EncodeJSON(m)
+}
+
+func (m *MetricLimitType) UnmarshalJSON(b []byte) error {
+	return metricLimitTypes.DecodeJSON(b, m)
+}
+
+func (m MetricLimitType) MarshalYAML() (interface{}, error) {
+	return metricLimitTypes.EncodeYAML(m)
+}
+
+func (m *MetricLimitType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return metricLimitTypes.DecodeYAML(unmarshal, m)
+}
+
+// MetricLimit
+// Introduced : BIG-IP_v9.2.0
+// A struct that describes a limit of an object, which is marked unavailable while the limit is exceeded.
+type MetricLimit struct {
+	MetricType MetricLimitType `xml:"metric_type" json:"metric_type" yaml:"metric_type"`
+	Value      int64           `xml:"value" json:"value" yaml:"value"`
+}
+
+// Limit are the limits of a pool or a pool member, which is marked unavailable while one of them is exceeded.
+// A zero value means no limit.
+type Limit struct {
+	BitsPerSecond      int64 `json:"bits_per_second" yaml:"bits_per_second"`
+	PacketsPerSecond   int64 `json:"packets_per_second" yaml:"packets_per_second"`
+	KilobitsPerSecond  int64 `json:"kilobits_per_second" yaml:"kilobits_per_second"`
+	CurrentConnections int64 `json:"current_connections" yaml:"current_connections"`
+}
+
+// NewLimit returns the limit described by limits. The metrics which do not apply to pools and members are ignored.
+func NewLimit(limits []MetricLimit) Limit {
+	var res Limit
+	for _, l := range limits {
+		switch l.MetricType {
+		case MetricLimitBitsPerSecond:
+			res.BitsPerSecond = l.Value
+		case MetricLimitPacketsPerSecond:
+			res.PacketsPerSecond = l.Value
+		case MetricLimitKilobitsPerSecond:
+			res.KilobitsPerSecond = l.Value
+		case MetricLimitCurrentConnections:
+			res.CurrentConnections = l.Value
+		}
+	}
+	return res
+}
+
+// MetricLimits returns the metric limits describing l. Each metric is sent, so that a zero value clears its limit.
+func (l Limit) MetricLimits() []MetricLimit {
+	return []MetricLimit{
+		{MetricType: MetricLimitBitsPerSecond, Value: l.BitsPerSecond},
+		{MetricType: MetricLimitPacketsPerSecond, Value: l.PacketsPerSecond},
+		{MetricType: MetricLimitKilobitsPerSecond, Value: l.KilobitsPerSecond},
+		{MetricType: MetricLimitCurrentConnections, Value: l.CurrentConnections},
+	}
+}
+
+// QoSCoefficients
+// Introduced : BIG-IP_v9.2.0
+// A struct that describes the weights of the metrics of the Quality of Service load balancing method.
+type QoSCoefficients struct {
+	RTT            int64 `xml:"rtt" json:"rtt" yaml:"rtt"`
+	Hops           int64 `xml:"hops" json:"hops" yaml:"hops"`
+	HitRatio       int64 `xml:"hit_ratio" json:"hit_ratio" yaml:"hit_ratio"`
+	PacketRate     int64 `xml:"packet_rate" json:"packet_rate" yaml:"packet_rate"`
+	VSCapacity     int64 `xml:"vs_capacity" json:"vs_capacity" yaml:"vs_capacity"`
+	BPS            int64 `xml:"bps" json:"bps" yaml:"bps"`
+	LinkCapacity   int64 `xml:"link_capacity" json:"link_capacity" yaml:"link_capacity"`
+	ConnectionRate int64 `xml:"connection_rate" json:"connection_rate" yaml:"connection_rate"`
+	VSScore        int64 `xml:"vs_score" json:"vs_score" yaml:"vs_score"`
+}
@@ -0,0 +1,87 @@
+package global_lb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wule61/go-f5-soap/common"
+	"gopkg.in/yaml.v3"
+)
+
+func TestModel_JSON(t *testing.T) {
+
+	id := WideIPID{WideIPName: "/Common/www.example.com", WideIPType: GtmQueryTypeAAAA}
+	bt, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"wideip_name":"/Common/www.example.com","wideip_type":"aaaa"}`; string(bt) != want {
+		t.Fatalf("expected %s, got %s", want, bt)
+	}
+
+	// The empty slices are left out.
+	rule := MonitorRule{Type: MonitorRuleTypeMOfN, Quorum: 1}
+	bt, err = json.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"type":"m-of-n","quorum":1}`; string(bt) != want {
+		t.Fatalf("expected %s, got %s", want, bt)
+	}
+
+	methods := []LBMethod{LBMethodRoundRobin, LBMethodGlobalAvailability, LBMethodVSCapacity}
+	bt, err = json.Marshal(methods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["round-robin","global-availability","vs-capacity"]`; string(bt) != want {
+		t.Fatalf("expected %s, got %s", want, bt)
+	}
+	var got []LBMethod
+	if err := json.Unmarshal(bt, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, methods) {
+		t.Fatalf("expected %v, got %v", methods, got)
+	}
+	if err := json.Unmarshal([]byte(`["round_robin"]`), &got); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestModel_YAML(t *testing.T) {
+
+	state := MonitorInstanceState{
+		Instance: MonitorInstance{
+			TemplateName: "/Common/http",
+			InstanceDefinition: MonitorIPPort{
+				AddressType: ATypeExplicitAddressExplicitPort,
+				IPPort:      common.IPPortDefinition{Address: "10.1.1.1", Port: 80},
+			},
+		},
+		InstanceState: InstanceStateDownByIRULE,
+		EnabledState:  true,
+	}
+
+	bt, err := yaml.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got MonitorInstanceState
+	if err := yaml.Unmarshal(bt, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != state {
+		t.Fatalf("expected %+v, got %+v", state, got)
+	}
+
+	var rule MonitorRule
+	if err := yaml.Unmarshal([]byte("type: and-list\nmonitor_templates: [/Common/http, /Common/tcp]\n"), &rule); err != nil {
+		t.Fatal(err)
+	}
+	want := MonitorRule{Type: MonitorRuleTypeAndList, MonitorTemplates: []string{"/Common/http", "/Common/tcp"}}
+	if !reflect.DeepEqual(rule, want) {
+		t.Fatalf("expected %+v, got %+v", want, rule)
+	}
+}
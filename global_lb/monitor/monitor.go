@@ -16,6 +16,9 @@ import (
 
 const tns = "urn:iControl:GlobalLB/Monitor"
 
+// TemplateType
+// Introduced : BIG-IP_v9.2.0
+// A list of template types.
 type TemplateType string
 
 const (
@@ -24,117 +27,208 @@ const (
 	TTypeUnset TemplateType = "TTYPE_UNSET"
 
 	// TTypeICMP The ICMP template type.
-	TTypeICMP = "TTYPE_ICMP"
+	TTypeICMP TemplateType = "TTYPE_ICMP"
 
 	// TTypeTCP The TCP template type.
-	TTypeTCP = "TTYPE_TCP"
+	TTypeTCP TemplateType = "TTYPE_TCP"
 
 	// TTypeTCPEcho The TCP_ECHO template type.
-	TTypeTCPEcho = "TTYPE_TCP_ECHO"
+	TTypeTCPEcho TemplateType = "TTYPE_TCP_ECHO"
 
 	// TTypeExternal The EXTERNAL template type.
-	TTypeExternal = "TTYPE_EXTERNAL"
+	TTypeExternal TemplateType = "TTYPE_EXTERNAL"
 
 	// TTypeHTTP The HTTP template type.
-	TTypeHTTP = "TTYPE_HTTP"
+	TTypeHTTP TemplateType = "TTYPE_HTTP"
 
 	// TTypeHTTPS The HTTPS template type.
-	TTypeHTTPS = "TTYPE_HTTPS"
+	TTypeHTTPS TemplateType = "TTYPE_HTTPS"
 
 	// TTypeNNTP The NNTP template type.
-	TTypeNNTP = "TTYPE_NNTP"
+	TTypeNNTP TemplateType = "TTYPE_NNTP"
 
 	// TTypeFTP The FTP template type.
-	TTypeFTP = "TTYPE_FTP"
+	TTypeFTP TemplateType = "TTYPE_FTP"
 
 	// TTypePOP3 The POP3 template type.
-	TTypePOP3 = "TTYPE_POP3"
+	TTypePOP3 TemplateType = "TTYPE_POP3"
 
 	// TTypeSMTP The SMTP template type.
-	TTypeSMTP = "TTYPE_SMTP"
+	TTypeSMTP TemplateType = "TTYPE_SMTP"
 
 	// TTypeMSSQL The MSSQL template type.
-	TTypeMSSQL = "TTYPE_MSSQL"
+	TTypeMSSQL TemplateType = "TTYPE_MSSQL"
 
 	// TTypeGateway The GATEWAY template type.
-	TTypeGateway = "TTYPE_GATEWAY"
+	TTypeGateway TemplateType = "TTYPE_GATEWAY"
 
 	// TTypeIMAP The IMAP template type.
-	TTypeIMAP = "TTYPE_IMAP"
+	TTypeIMAP TemplateType = "TTYPE_IMAP"
 
 	// TTypeRadius The RADIUS template type.
-	TTypeRadius = "TTYPE_RADIUS"
+	TTypeRadius TemplateType = "TTYPE_RADIUS"
 
 	// TTypeLDAP The LDAP template type.
-	TTypeLDAP = "TTYPE_LDAP"
+	TTypeLDAP TemplateType = "TTYPE_LDAP"
 
 	// TTypeWMI The WMI template type.
-	TTypeWMI = "TTYPE_WMI"
+	TTypeWMI TemplateType = "TTYPE_WMI"
 
 	// TTypeSnmpDca The SNMP_DCA template type.
-	TTypeSnmpDca = "TTYPE_SNMP_DCA"
+	TTypeSnmpDca TemplateType = "TTYPE_SNMP_DCA"
 
 	// TTypeSnmpDcaBase The SNMP_DCA_BASE template type.
-	TTypeSnmpDcaBase = "TTYPE_SNMP_DCA_BASE"
+	TTypeSnmpDcaBase TemplateType = "TTYPE_SNMP_DCA_BASE"
 
 	// TTypeRealServer The REAL_SERVER template type.
-	TTypeRealServer = "TTYPE_REAL_SERVER"
+	TTypeRealServer TemplateType = "TTYPE_REAL_SERVER"
 
 	// TTypeUDP The UDP template type.
-	TTypeUDP = "TTYPE_UDP"
+	TTypeUDP TemplateType = "TTYPE_UDP"
 
 	// TTypeNone Not using any monitor template.
-	TTypeNone = "TTYPE_NONE"
+	TTypeNone TemplateType = "TTYPE_NONE"
 
 	// TTypeOracle The ORACLE template type.
-	TTypeOracle = "TTYPE_ORACLE"
+	TTypeOracle TemplateType = "TTYPE_ORACLE"
 
 	// TTypeSoap The SOAP template type.
-	TTypeSoap = "TTYPE_SOAP"
+	TTypeSoap TemplateType = "TTYPE_SOAP"
 
 	// TTypeGatewayICMP The GATEWAY_ICMP template type.
-	TTypeGatewayICMP = "TTYPE_GATEWAY_ICMP"
+	TTypeGatewayICMP TemplateType = "TTYPE_GATEWAY_ICMP"
 
 	// TTypeSIP The SIP template type.
-	TTypeSIP = "TTYPE_SIP"
+	TTypeSIP TemplateType = "TTYPE_SIP"
 
 	// TTypeTCPHalfOpen The TCP_HALF_OPEN template type.
-	TTypeTCPHalfOpen = "TTYPE_TCP_HALF_OPEN"
+	TTypeTCPHalfOpen TemplateType = "TTYPE_TCP_HALF_OPEN"
 
 	// TTypeScripted The SCRIPTED template type.
-	TTypeScripted = "TTYPE_SCRIPTED"
+	TTypeScripted TemplateType = "TTYPE_SCRIPTED"
 
 	// TTypeWAP The WAP template type.
-	TTypeWAP = "TTYPE_WAP"
+	TTypeWAP TemplateType = "TTYPE_WAP"
 
 	// TTypeBIGIP The BIGIP template type.
-	TTypeBIGIP = "TTYPE_BIGIP"
+	TTypeBIGIP TemplateType = "TTYPE_BIGIP"
 
 	// TTypeBIGIPLink The BIGIP_LINK template type.
-	TTypeBIGIPLink = "TTYPE_BIGIP_LINK"
+	TTypeBIGIPLink TemplateType = "TTYPE_BIGIP_LINK"
 
 	// TTypeSnmpGtm The SNMP_GTM template type.
-	TTypeSnmpGtm = "TTYPE_SNMP_GTM"
+	TTypeSnmpGtm TemplateType = "TTYPE_SNMP_GTM"
 
 	// TTypeSnmpLink The SNMP_LINK template type.
-	TTypeSnmpLink = "TTYPE_SNMP_LINK"
+	TTypeSnmpLink TemplateType = "TTYPE_SNMP_LINK"
 
 	// TTypeFirePassGtm Template type for monitoring Firepass servers
-	TTypeFirePassGtm = "TTYPE_FIREPASS_GTM"
+	TTypeFirePassGtm TemplateType = "TTYPE_FIREPASS_GTM"
 
 	// TTypeRadiusAccounting The RADIUS ACCOUNTING template type.
-	TTypeRadiusAccounting = "TTYPE_RADIUS_ACCOUNTING"
+	TTypeRadiusAccounting TemplateType = "TTYPE_RADIUS_ACCOUNTING"
 
 	// TTypeDiameter The Diameter authorization, authentication, and accounting server template type.
-	TTypeDiameter = "TTYPE_DIAMETER"
+	TTypeDiameter TemplateType = "TTYPE_DIAMETER"
 
 	// TTypeMysql The MySQL monitor template type.This monitor verifies MySQL-based services.
-	TTypeMysql = "TTYPE_MYSQL"
+	TTypeMysql TemplateType = "TTYPE_MYSQL"
 
 	// TTypePostgreSQL The PostgreSQL monitor template type.This monitor verifies PostgreSQL-based services.
-	TTypePostgreSQL = "TTYPE_POSTGRESQL"
+	TTypePostgreSQL TemplateType = "TTYPE_POSTGRESQL"
+)
+
+// templateTypes are the values of TemplateType.
+var templateTypes = common.NewEnum("TemplateType", "TTYPE_", "BIG-IP_v9.2.0",
+	TTypeUnset,
+	TTypeICMP,
+	TTypeTCP,
+	TTypeTCPEcho,
+	TTypeExternal,
+	TTypeHTTP,
+	TTypeHTTPS,
+	TTypeNNTP,
+	TTypeFTP,
+	TTypePOP3,
+	TTypeSMTP,
+	TTypeMSSQL,
+	TTypeGateway,
+	TTypeIMAP,
+	TTypeRadius,
+	TTypeLDAP,
+	TTypeWMI,
+	TTypeSnmpDca,
+	TTypeSnmpDcaBase,
+	TTypeRealServer,
+	TTypeUDP,
+	TTypeNone,
+	TTypeOracle,
+	TTypeSoap,
+	TTypeGatewayICMP,
+	TTypeSIP,
+	TTypeTCPHalfOpen,
+	TTypeScripted,
+	TTypeWAP,
+	TTypeBIGIP,
+	TTypeBIGIPLink,
+	TTypeSnmpGtm,
+	TTypeSnmpLink,
+	TTypeFirePassGtm,
+	TTypeRadiusAccounting,
+	TTypeDiameter,
+	TTypeMysql,
+	TTypePostgreSQL,
 )
 
+// ParseTemplateType returns the TemplateType whose short or iControl form is s, e.g. tcp or TTYPE_TCP.
+func ParseTemplateType(s string) (TemplateType, error) {
+	return templateTypes.Parse(s)
+}
+
+// TemplateTypeValues returns the values of TemplateType.
+func TemplateTypeValues() []TemplateType {
+	return templateTypes.Values()
+}
+
+// IsValid reports whether t is one of the TemplateType values.
+func (t TemplateType) IsValid() bool {
+	return templateTypes.IsValid(t)
+}
+
+// String returns the iControl value of t.
+func (t TemplateType) String() string {
+	return string(t)
+}
+
+// Short returns the short form of t, e.g. tcp.
+func (t TemplateType) Short() string {
+	return templateTypes.Short(t)
+}
+
+// Introduced returns the BIG-IP version which introduced TemplateType.
+func (t TemplateType) Introduced() string {
+	return templateTypes.Introduced()
+}
+
+func (t TemplateType) MarshalJSON() ([]byte, error) {
+	return templateTypes.EncodeJSON(t)
+}
+
+func (t *TemplateType) UnmarshalJSON(b []byte) error {
+	return templateTypes.DecodeJSON(b, t)
+}
+
+func (t TemplateType) MarshalYAML() (interface{}, error) {
+	return templateTypes.EncodeYAML(t)
+}
+
+func (t *TemplateType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return templateTypes.DecodeYAML(unmarshal, t)
+}
+
+// IntPropertyType
+// Introduced : BIG-IP_v9.2.0
+// A list of integer property types.
 type IntPropertyType string
 
 const (
@@ -142,29 +236,89 @@ const (
 	ITypeUnset IntPropertyType = "ITYPE_UNSET"
 
 	// ITypeInterval The integer property type used to change the value of interval.
-	ITypeInterval = "ITYPE_INTERVAL"
+	ITypeInterval IntPropertyType = "ITYPE_INTERVAL"
 
 	// ITypeTimeOut The integer property type used to change the value of timeout.
-	ITypeTimeOut = "ITYPE_TIMEOUT"
+	ITypeTimeOut IntPropertyType = "ITYPE_TIMEOUT"
 
 	// ITypeProbeInterval The integer property type used to change the value of the probing interval.
-	ITypeProbeInterval = "ITYPE_PROBE_INTERVAL"
+	ITypeProbeInterval IntPropertyType = "ITYPE_PROBE_INTERVAL"
 
 	// ITypeProbeTimeOut The integer property type used to change the value of the probing timeout.
-	ITypeProbeTimeOut = "ITYPE_PROBE_TIMEOUT"
+	ITypeProbeTimeOut IntPropertyType = "ITYPE_PROBE_TIMEOUT"
 
 	// ITypeProbeNumProbes The integer property type used to change the number of probes.
-	ITypeProbeNumProbes = "ITYPE_PROBE_NUM_PROBES"
+	ITypeProbeNumProbes IntPropertyType = "ITYPE_PROBE_NUM_PROBES"
 
 	// ITypeProbeNumSuccesses The integer property type used to change the number of successful probes.
-	ITypeProbeNumSuccesses = "ITYPE_PROBE_NUM_SUCCESSES"
+	ITypeProbeNumSuccesses IntPropertyType = "ITYPE_PROBE_NUM_SUCCESSES"
 )
 
+// intPropertyTypes are the values of IntPropertyType.
+var intPropertyTypes = common.NewEnum("IntPropertyType", "ITYPE_", "BIG-IP_v9.2.0",
+	ITypeUnset,
+	ITypeInterval,
+	ITypeTimeOut,
+	ITypeProbeInterval,
+	ITypeProbeTimeOut,
+	ITypeProbeNumProbes,
+	ITypeProbeNumSuccesses,
+)
+
+// ParseIntPropertyType returns the IntPropertyType whose short or iControl form is s, e.g. timeout or ITYPE_TIMEOUT.
+func ParseIntPropertyType(s string) (IntPropertyType, error) {
+	return intPropertyTypes.Parse(s)
+}
+
+// IntPropertyTypeValues returns the values of IntPropertyType.
+func IntPropertyTypeValues() []IntPropertyType {
+	return intPropertyTypes.Values()
+}
+
+// IsValid reports whether i is one of the IntPropertyType values.
+func (i IntPropertyType) IsValid() bool {
+	return intPropertyTypes.IsValid(i)
+}
+
+// String returns the iControl value of i.
+func (i IntPropertyType) String() string {
+	return string(i)
+}
+
+// Short returns the short form of i, e.g. timeout.
+func (i IntPropertyType) Short() string {
+	return intPropertyTypes.Short(i)
+}
+
+// Introduced returns the BIG-IP version which introduced IntPropertyType.
+func (i IntPropertyType) Introduced() string {
+	return intPropertyTypes.Introduced()
+}
+
+func (i IntPropertyType) MarshalJSON() ([]byte, error) {
+	return intPropertyTypes.EncodeJSON(i)
+}
+
+func (i *IntPropertyType) UnmarshalJSON(b []byte) error {
+	return intPropertyTypes.DecodeJSON(b, i)
+}
+
+func (i IntPropertyType) MarshalYAML() (interface{}, error) {
+	return intPropertyTypes.EncodeYAML(i)
+}
+
+func (i *IntPropertyType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return intPropertyTypes.DecodeYAML(unmarshal, i)
+}
+
 type IntegerValue struct {
-	Type  IntPropertyType `xml:"type"`  // The integer property type.
-	Value int64           `xml:"value"` // The integer property value.
+	Type  IntPropertyType `xml:"type" json:"type" yaml:"type"`    // The integer property type.
+	Value int64           `xml:"value" json:"value" yaml:"value"` // The integer property value.
 }
 
+// StrPropertyType
+// Introduced : BIG-IP_v9.2.0
+// A list of string property types.
 type StrPropertyType string
 
 const (
@@ -172,259 +326,401 @@ const (
 	STYPE_UNSET StrPropertyType = "STYPE_UNSET"
 
 	// STYPE_SEND The string property type used to change a string value of a template (TCP, HTTP, HTTPS).
-	STYPE_SEND = "STYPE_SEND"
+	STYPE_SEND StrPropertyType = "STYPE_SEND"
 
 	// STYPE_GET The string property type used to change a string value of a template (HTTP, HTTPS, FTP).
-	STYPE_GET = "STYPE_GET"
+	STYPE_GET StrPropertyType = "STYPE_GET"
 
 	// STYPE_RECEIVE The string property type used to change a string value of a template (TCP, HTTP, HTTPS).
-	STYPE_RECEIVE = "STYPE_RECEIVE"
+	STYPE_RECEIVE StrPropertyType = "STYPE_RECEIVE"
 
 	//The string property type used to change a string value of a template (HTTP, HTTPS, NNTP, FTP, POP3, SQL, IMAP, RADIUS, RADIUS_ACCOUNTING, LDAP, WMI).
-	STYPE_USERNAME = "STYPE_USERNAME"
+	STYPE_USERNAME StrPropertyType = "STYPE_USERNAME"
 
 	//The string property type used to change a string value of a template (HTTP, HTTPS, NNTP, FTP, POP3, SQL, IMAP, RADIUS, LDAP, WMI).
-	STYPE_PASSWORD = "STYPE_PASSWORD"
+	STYPE_PASSWORD StrPropertyType = "STYPE_PASSWORD"
 
 	//The string property specifying the name of the executeable file run in an external monitor. Monitor executable files are officially managed as external monitor file objects via the STYPE_RUN_V2 property and the System::ExternalMonitorFile interface. Thus this value has been deprecated.
-	STYPE_RUN = "STYPE_RUN"
+	STYPE_RUN StrPropertyType = "STYPE_RUN"
 
 	//The string property type used to change a string value of a template (NNTP).
-	STYPE_NEWSGROUP = "STYPE_NEWSGROUP"
+	STYPE_NEWSGROUP StrPropertyType = "STYPE_NEWSGROUP"
 
 	//The string property type used to change a string value of a template (SQL).
-	STYPE_DATABASE = "STYPE_DATABASE"
+	STYPE_DATABASE StrPropertyType = "STYPE_DATABASE"
 
 	//The string property type used to change a string value of a template (SMTP).
-	STYPE_DOMAIN = "STYPE_DOMAIN"
+	STYPE_DOMAIN StrPropertyType = "STYPE_DOMAIN"
 
 	//The string property type used to change a string value of a template (EXTERNAL).
-	STYPE_ARGUMENTS = "STYPE_ARGUMENTS"
+	STYPE_ARGUMENTS StrPropertyType = "STYPE_ARGUMENTS"
 
 	//The string property type used to change a string value of a template (IMAP).
-	STYPE_FOLDER = "STYPE_FOLDER"
+	STYPE_FOLDER StrPropertyType = "STYPE_FOLDER"
 
 	//	The string property type used to change a string value of a template (LDAP).
-	STYPE_BASE = "STYPE_BASE"
+	STYPE_BASE StrPropertyType = "STYPE_BASE"
 
 	//The string property type used to change a string value of a template (LDAP).
-	STYPE_FILTER = "STYPE_FILTER"
+	STYPE_FILTER StrPropertyType = "STYPE_FILTER"
 
 	//The string property type used to change a string value of a template (RADIUS, RADIUS_ACCOUNTING).
-	STYPE_SECRET = "STYPE_SECRET"
+	STYPE_SECRET StrPropertyType = "STYPE_SECRET"
 
 	//	The string property type used to change a string value of a template (WMI, REAL_SERVER).
-	STYPE_METHOD = "STYPE_METHOD"
+	STYPE_METHOD StrPropertyType = "STYPE_METHOD"
 
 	//The string property type used to change a string value of a template (WMI).
-	STYPE_URL = "STYPE_URL"
+	STYPE_URL StrPropertyType = "STYPE_URL"
 
 	//The string property type used to change a string value of a template (WMI, REAL_SERVER).
-	STYPE_COMMAND = "STYPE_COMMAND"
+	STYPE_COMMAND StrPropertyType = "STYPE_COMMAND"
 
 	//The string property type used to change a string value of a template (WMI, REAL_SERVER).
-	STYPE_METRICS = "STYPE_METRICS"
+	STYPE_METRICS StrPropertyType = "STYPE_METRICS"
 
 	//The string property type used to change a string value of a template (WMI).
-	STYPE_POST = "STYPE_POST"
+	STYPE_POST StrPropertyType = "STYPE_POST"
 
 	//The string property type used to change a string value of a template (WMI, REAL_SERVER).
-	STYPE_USERAGENT = "STYPE_USERAGENT"
+	STYPE_USERAGENT StrPropertyType = "STYPE_USERAGENT"
 
 	//The string property type used to change a string value of a template (SNMP_DCA ).
-	STYPE_AGENT_TYPE = "STYPE_AGENT_TYPE"
+	STYPE_AGENT_TYPE StrPropertyType = "STYPE_AGENT_TYPE"
 
 	//The string property type used to change a string value of a template (SNMP_DCA).
-	STYPE_CPU_COEFFICIENT = "STYPE_CPU_COEFFICIENT"
+	STYPE_CPU_COEFFICIENT StrPropertyType = "STYPE_CPU_COEFFICIENT"
 
 	//The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA).
-	STYPE_CPU_THRESHOLD = "STYPE_CPU_THRESHOLD"
+	STYPE_CPU_THRESHOLD StrPropertyType = "STYPE_CPU_THRESHOLD"
 
 	//The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA).
-	STYPE_MEMORY_COEFFICIENT = "STYPE_MEMORY_COEFFICIENT"
+	STYPE_MEMORY_COEFFICIENT StrPropertyType = "STYPE_MEMORY_COEFFICIENT"
 
 	// The string property type used to  change a string value of a template (SNMP_DCA).
-	STYPE_MEMORY_THRESHOLD = "STYPE_MEMORY_THRESHOLD"
+	STYPE_MEMORY_THRESHOLD StrPropertyType = "STYPE_MEMORY_THRESHOLD"
 
 	//The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA).
-	STYPE_DISK_COEFFICIENT = "STYPE_DISK_COEFFICIENT"
+	STYPE_DISK_COEFFICIENT StrPropertyType = "STYPE_DISK_COEFFICIENT"
 
 	//The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA).
-	STYPE_DISK_THRESHOLD = "STYPE_DISK_THRESHOLD"
+	STYPE_DISK_THRESHOLD StrPropertyType = "STYPE_DISK_THRESHOLD"
 
 	//The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA, SNMP_DCA_BASE).
-	STYPE_SNMP_VERSION = "STYPE_SNMP_VERSION"
+	STYPE_SNMP_VERSION StrPropertyType = "STYPE_SNMP_VERSION"
 
 	// The string property
 	//type used to
 	//change a string value of a template (SNMP_DCA, SNMP_DCA_BASE).
-	STYPE_COMMUNITY = "STYPE_COMMUNITY"
+	STYPE_COMMUNITY StrPropertyType = "STYPE_COMMUNITY"
 
 	//This string property
 	//type is no
 	//longer effective and must be considered deprecated.
-	STYPE_SEND_PACKETS = "STYPE_SEND_PACKETS"
+	STYPE_SEND_PACKETS StrPropertyType = "STYPE_SEND_PACKETS"
 
 	//This string property
 	//type is no
 	//longer effective and must be considered deprecated.
-	STYPE_TIMEOUT_PACKETS = "STYPE_TIMEOUT_PACKETS"
+	STYPE_TIMEOUT_PACKETS StrPropertyType = "STYPE_TIMEOUT_PACKETS"
 
 	//The string property
 	//type used to
 	//disable new sessions upon a match (also known as receive disable).
-	STYPE_RECEIVE_DRAIN = "STYPE_RECEIVE_DRAIN"
+	STYPE_RECEIVE_DRAIN StrPropertyType = "STYPE_RECEIVE_DRAIN"
 
 	// The string property
 	//type used in
 	//database template.
-	STYPE_RECEIVE_ROW = "STYPE_RECEIVE_ROW"
+	STYPE_RECEIVE_ROW StrPropertyType = "STYPE_RECEIVE_ROW"
 
 	// The string property
 	//type used in
 	//database template.
-	STYPE_RECEIVE_COLUMN = "STYPE_RECEIVE_COLUMN"
+	STYPE_RECEIVE_COLUMN StrPropertyType = "STYPE_RECEIVE_COLUMN"
 
 	//The string property
 	//type used to
 	//enable EAV logging.
-	STYPE_DEBUG = "STYPE_DEBUG"
+	STYPE_DEBUG StrPropertyType = "STYPE_DEBUG"
 
 	// The string property
 	//type used in
 	//LDAP template.
-	STYPE_SECURITY = "STYPE_SECURITY"
+	STYPE_SECURITY StrPropertyType = "STYPE_SECURITY"
 
 	// The string property
 	//type used to
 	//indicate UDPs passive mode or port.
-	STYPE_MODE = "STYPE_MODE"
+	STYPE_MODE StrPropertyType = "STYPE_MODE"
 
 	// The string property
 	//type used to
 	//represent the HTTPS cipher list.
-	STYPE_CIPHER_LIST = "STYPE_CIPHER_LIST"
+	STYPE_CIPHER_LIST StrPropertyType = "STYPE_CIPHER_LIST"
 
-	STYPE_NAMESPACE = "STYPE_NAMESPACE"
+	STYPE_NAMESPACE StrPropertyType = "STYPE_NAMESPACE"
 
-	STYPE_PARAMETER_NAME = "STYPE_PARAMETER_NAME"
+	STYPE_PARAMETER_NAME StrPropertyType = "STYPE_PARAMETER_NAME"
 
-	STYPE_PARAMETER_VALUE = "STYPE_PARAMETER_VALUE"
+	STYPE_PARAMETER_VALUE StrPropertyType = "STYPE_PARAMETER_VALUE"
 
-	STYPE_PARAMETER_TYPE = "STYPE_PARAMETER_TYPE"
+	STYPE_PARAMETER_TYPE StrPropertyType = "STYPE_PARAMETER_TYPE"
 
-	STYPE_RETURN_TYPE = "STYPE_RETURN_TYPE"
+	STYPE_RETURN_TYPE StrPropertyType = "STYPE_RETURN_TYPE"
 
-	STYPE_RETURN_VALUE = "STYPE_RETURN_VALUE"
+	STYPE_RETURN_VALUE StrPropertyType = "STYPE_RETURN_VALUE"
 
-	STYPE_SOAP_FAULT = "STYPE_SOAP_FAULT"
+	STYPE_SOAP_FAULT StrPropertyType = "STYPE_SOAP_FAULT"
 
-	STYPE_SSL_OPTIONS = "STYPE_SSL_OPTIONS"
+	STYPE_SSL_OPTIONS StrPropertyType = "STYPE_SSL_OPTIONS"
 
-	STYPE_CLIENT_CERTIFICATE = "STYPE_CLIENT_CERTIFICATE"
+	STYPE_CLIENT_CERTIFICATE StrPropertyType = "STYPE_CLIENT_CERTIFICATE"
 
-	STYPE_PROTOCOL = "STYPE_PROTOCOL"
+	STYPE_PROTOCOL StrPropertyType = "STYPE_PROTOCOL"
 
-	STYPE_MANDATORY_ATTRS = "STYPE_MANDATORY_ATTRS"
+	STYPE_MANDATORY_ATTRS StrPropertyType = "STYPE_MANDATORY_ATTRS"
 
-	STYPE_FILENAME = "STYPE_FILENAME"
+	STYPE_FILENAME StrPropertyType = "STYPE_FILENAME"
 
-	STYPE_ACCOUNTING_NODE = "STYPE_ACCOUNTING_NODE"
+	STYPE_ACCOUNTING_NODE StrPropertyType = "STYPE_ACCOUNTING_NODE"
 
-	STYPE_ACCOUNTING_PORT = "STYPE_ACCOUNTING_PORT"
+	STYPE_ACCOUNTING_PORT StrPropertyType = "STYPE_ACCOUNTING_PORT"
 
-	STYPE_SERVER_ID = "STYPE_SERVER_ID"
+	STYPE_SERVER_ID StrPropertyType = "STYPE_SERVER_ID"
 
-	STYPE_CALL_ID = "STYPE_CALL_ID"
+	STYPE_CALL_ID StrPropertyType = "STYPE_CALL_ID"
 
-	STYPE_SESSION_ID = "STYPE_SESSION_ID"
+	STYPE_SESSION_ID StrPropertyType = "STYPE_SESSION_ID"
 
-	STYPE_FRAMED_ADDRESS = "STYPE_FRAMED_ADDRESS"
+	STYPE_FRAMED_ADDRESS StrPropertyType = "STYPE_FRAMED_ADDRESS"
 
-	STYPE_SNMP_PORT = "STYPE_SNMP_PORT"
+	STYPE_SNMP_PORT StrPropertyType = "STYPE_SNMP_PORT"
 
-	STYPE_AGGREGATE_DYNAMIC_RATIOS = "STYPE_AGGREGATE_DYNAMIC_RATIOS"
+	STYPE_AGGREGATE_DYNAMIC_RATIOS StrPropertyType = "STYPE_AGGREGATE_DYNAMIC_RATIOS"
 
-	STYPE_DB_COUNT = "STYPE_DB_COUNT"
+	STYPE_DB_COUNT StrPropertyType = "STYPE_DB_COUNT"
 
-	STYPE_NAS_IP = "STYPE_NAS_IP"
+	STYPE_NAS_IP StrPropertyType = "STYPE_NAS_IP"
 
-	STYPE_CLIENT_KEY = "STYPE_CLIENT_KEY"
+	STYPE_CLIENT_KEY StrPropertyType = "STYPE_CLIENT_KEY"
 
-	STYPE_MAX_LOAD_AVERAGE = "STYPE_MAX_LOAD_AVERAGE"
+	STYPE_MAX_LOAD_AVERAGE StrPropertyType = "STYPE_MAX_LOAD_AVERAGE"
 
-	STYPE_CONCURRENCY_LIMIT = "STYPE_CONCURRENCY_LIMIT"
+	STYPE_CONCURRENCY_LIMIT StrPropertyType = "STYPE_CONCURRENCY_LIMIT"
 
-	STYPE_FILTER_NEG = "STYPE_FILTER_NEG"
+	STYPE_FILTER_NEG StrPropertyType = "STYPE_FILTER_NEG"
 
-	STYPE_REQUEST = "STYPE_REQUEST"
+	STYPE_REQUEST StrPropertyType = "STYPE_REQUEST"
 
-	STYPE_HEADERS = "STYPE_HEADERS"
+	STYPE_HEADERS StrPropertyType = "STYPE_HEADERS"
 
-	STYPE_DIAMETER_ACCT_APPLICATION_ID = "STYPE_DIAMETER_ACCT_APPLICATION_ID"
+	STYPE_DIAMETER_ACCT_APPLICATION_ID StrPropertyType = "STYPE_DIAMETER_ACCT_APPLICATION_ID"
 
-	STYPE_DIAMETER_AUTH_APPLICATION_ID = "STYPE_DIAMETER_AUTH_APPLICATION_ID"
+	STYPE_DIAMETER_AUTH_APPLICATION_ID StrPropertyType = "STYPE_DIAMETER_AUTH_APPLICATION_ID"
 
-	STYPE_DIAMETER_ORIGIN_HOST = "STYPE_DIAMETER_ORIGIN_HOST"
+	STYPE_DIAMETER_ORIGIN_HOST StrPropertyType = "STYPE_DIAMETER_ORIGIN_HOST"
 
-	STYPE_DIAMETER_ORIGIN_REALM = "STYPE_DIAMETER_ORIGIN_REALM"
+	STYPE_DIAMETER_ORIGIN_REALM StrPropertyType = "STYPE_DIAMETER_ORIGIN_REALM"
 
-	STYPE_DIAMETER_HOST_IP_ADDRESS = "STYPE_DIAMETER_HOST_IP_ADDRESS"
+	STYPE_DIAMETER_HOST_IP_ADDRESS StrPropertyType = "STYPE_DIAMETER_HOST_IP_ADDRESS"
 
-	STYPE_DIAMETER_VENDOR_ID = "STYPE_DIAMETER_VENDOR_ID"
+	STYPE_DIAMETER_VENDOR_ID StrPropertyType = "STYPE_DIAMETER_VENDOR_ID"
 
-	STYPE_DIAMETER_PRODUCT_NAME = "STYPE_DIAMETER_PRODUCT_NAME"
+	STYPE_DIAMETER_PRODUCT_NAME StrPropertyType = "STYPE_DIAMETER_PRODUCT_NAME"
 
-	STYPE_DIAMETER_VENDOR_SPECIFIC_VENDOR_ID = "STYPE_DIAMETER_VENDOR_SPECIFIC_VENDOR_ID"
+	STYPE_DIAMETER_VENDOR_SPECIFIC_VENDOR_ID StrPropertyType = "STYPE_DIAMETER_VENDOR_SPECIFIC_VENDOR_ID"
 
-	STYPE_DIAMETER_VENDOR_SPECIFIC_ACCT_APPLICATION_ID = "STYPE_DIAMETER_VENDOR_SPECIFIC_ACCT_APPLICATION_ID"
+	STYPE_DIAMETER_VENDOR_SPECIFIC_ACCT_APPLICATION_ID StrPropertyType = "STYPE_DIAMETER_VENDOR_SPECIFIC_ACCT_APPLICATION_ID"
 
-	STYPE_DIAMETER_VENDOR_SPECIFIC_AUTH_APPLICATION_ID = "STYPE_DIAMETER_VENDOR_SPECIFIC_AUTH_APPLICATION_ID"
+	STYPE_DIAMETER_VENDOR_SPECIFIC_AUTH_APPLICATION_ID StrPropertyType = "STYPE_DIAMETER_VENDOR_SPECIFIC_AUTH_APPLICATION_ID"
 
-	STYPE_RUN_V2 = "STYPE_RUN_V2"
+	STYPE_RUN_V2 StrPropertyType = "STYPE_RUN_V2"
 
-	STYPE_CLIENT_CERTIFICATE_V2 = "STYPE_CLIENT_CERTIFICATE_V2"
+	STYPE_CLIENT_CERTIFICATE_V2 StrPropertyType = "STYPE_CLIENT_CERTIFICATE_V2"
 
-	STYPE_CLIENT_KEY_V2 = "STYPE_CLIENT_KEY_V2"
+	STYPE_CLIENT_KEY_V2 StrPropertyType = "STYPE_CLIENT_KEY_V2"
 )
 
+// strPropertyTypes are the values of StrPropertyType.
+var strPropertyTypes = common.NewEnum("StrPropertyType", "STYPE_", "BIG-IP_v9.2.0",
+	STYPE_UNSET,
+	STYPE_SEND,
+	STYPE_GET,
+	STYPE_RECEIVE,
+	STYPE_USERNAME,
+	STYPE_PASSWORD,
+	STYPE_RUN,
+	STYPE_NEWSGROUP,
+	STYPE_DATABASE,
+	STYPE_DOMAIN,
+	STYPE_ARGUMENTS,
+	STYPE_FOLDER,
+	STYPE_BASE,
+	STYPE_FILTER,
+	STYPE_SECRET,
+	STYPE_METHOD,
+	STYPE_URL,
+	STYPE_COMMAND,
+	STYPE_METRICS,
+	STYPE_POST,
+	STYPE_USERAGENT,
+	STYPE_AGENT_TYPE,
+	STYPE_CPU_COEFFICIENT,
+	STYPE_CPU_THRESHOLD,
+	STYPE_MEMORY_COEFFICIENT,
+	STYPE_MEMORY_THRESHOLD,
+	STYPE_DISK_COEFFICIENT,
+	STYPE_DISK_THRESHOLD,
+	STYPE_SNMP_VERSION,
+	STYPE_COMMUNITY,
+	STYPE_SEND_PACKETS,
+	STYPE_TIMEOUT_PACKETS,
+	STYPE_RECEIVE_DRAIN,
+	STYPE_RECEIVE_ROW,
+	STYPE_RECEIVE_COLUMN,
+	STYPE_DEBUG,
+	STYPE_SECURITY,
+	STYPE_MODE,
+	STYPE_CIPHER_LIST,
+	STYPE_NAMESPACE,
+	STYPE_PARAMETER_NAME,
+	STYPE_PARAMETER_VALUE,
+	STYPE_PARAMETER_TYPE,
+	STYPE_RETURN_TYPE,
+	STYPE_RETURN_VALUE,
+	STYPE_SOAP_FAULT,
+	STYPE_SSL_OPTIONS,
+	STYPE_CLIENT_CERTIFICATE,
+	STYPE_PROTOCOL,
+	STYPE_MANDATORY_ATTRS,
+	STYPE_FILENAME,
+	STYPE_ACCOUNTING_NODE,
+	STYPE_ACCOUNTING_PORT,
+	STYPE_SERVER_ID,
+	STYPE_CALL_ID,
+	STYPE_SESSION_ID,
+	STYPE_FRAMED_ADDRESS,
+	STYPE_SNMP_PORT,
+	STYPE_AGGREGATE_DYNAMIC_RATIOS,
+	STYPE_DB_COUNT,
+	STYPE_NAS_IP,
+	STYPE_CLIENT_KEY,
+	STYPE_MAX_LOAD_AVERAGE,
+	STYPE_CONCURRENCY_LIMIT,
+	STYPE_FILTER_NEG,
+	STYPE_REQUEST,
+	STYPE_HEADERS,
+	STYPE_DIAMETER_ACCT_APPLICATION_ID,
+	STYPE_DIAMETER_AUTH_APPLICATION_ID,
+	STYPE_DIAMETER_ORIGIN_HOST,
+	STYPE_DIAMETER_ORIGIN_REALM,
+	STYPE_DIAMETER_HOST_IP_ADDRESS,
+	STYPE_DIAMETER_VENDOR_ID,
+	STYPE_DIAMETER_PRODUCT_NAME,
+	STYPE_DIAMETER_VENDOR_SPECIFIC_VENDOR_ID,
+	STYPE_DIAMETER_VENDOR_SPECIFIC_ACCT_APPLICATION_ID,
+	STYPE_DIAMETER_VENDOR_SPECIFIC_AUTH_APPLICATION_ID,
+	STYPE_RUN_V2,
+	STYPE_CLIENT_CERTIFICATE_V2,
+	STYPE_CLIENT_KEY_V2,
+)
+
+// ParseStrPropertyType returns the StrPropertyType whose short or iControl form is s, e.g. get or STYPE_GET.
+func ParseStrPropertyType(s string) (StrPropertyType, error) {
+	return strPropertyTypes.Parse(s)
+}
+
+// StrPropertyTypeValues returns the values of StrPropertyType.
+func StrPropertyTypeValues() []StrPropertyType {
+	return strPropertyTypes.Values()
+}
+
+// IsValid reports whether s is one of the StrPropertyType values.
+func (s StrPropertyType) IsValid() bool {
+	return strPropertyTypes.IsValid(s)
+}
+
+// String returns the iControl value of s.
+func (s StrPropertyType) String() string {
+	return string(s)
+}
+
+// Short returns the short form of s, e.g. get.
+func (s StrPropertyType) Short() string {
+	return strPropertyTypes.Short(s)
+}
+
+// Introduced returns the BIG-IP version which introduced StrPropertyType.
+func (s StrPropertyType) Introduced() string {
+	return strPropertyTypes.Introduced()
+}
+
+func (s StrPropertyType) MarshalJSON() ([]byte, error) {
+	return strPropertyTypes.EncodeJSON(s)
+}
+
+func (s *StrPropertyType) UnmarshalJSON(b []byte) error {
+	return strPropertyTypes.DecodeJSON(b, s)
+}
+
+func (s StrPropertyType) MarshalYAML() (interface{}, error) {
+	return strPropertyTypes.EncodeYAML(s)
+}
+
+func (s *StrPropertyType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return strPropertyTypes.DecodeYAML(unmarshal, s)
+}
+
 type StringValue struct {
-	Type  StrPropertyType `xml:"type"`
-	Value string          `xml:"value"`
+	Type  StrPropertyType `xml:"type" json:"type" yaml:"type"`
+	Value string          `xml:"value" json:"value" yaml:"value"`
 }
 
 type UserDefinedStringValue struct {
-	Name  string // The user-defined string property name.
-	Value string // The user-defined string property value.
+	Name  string `json:"name" yaml:"name"`   // The user-defined string property name.
+	Value string `json:"value" yaml:"value"` // The user-defined string property value.
 }
 type IMonitor interface {
 	GetTemplateList() ([]MonitorTemplate, error)
+	GetTemplateListCtx(ctx context.Context) ([]MonitorTemplate, error)
 	GetTemplateType(templateNames []string) ([]TemplateType, error)
+	GetTemplateTypeCtx(ctx context.Context, templateNames []string) ([]TemplateType, error)
 	GetParentTemplate(templateNames []string) ([]string, error)
+	GetParentTemplateCtx(ctx context.Context, templateNames []string) ([]string, error)
 	GetTemplateAddressType(templateNames []string) ([]global_lb.AddressType, error)
+	GetTemplateAddressTypeCtx(ctx context.Context, templateNames []string) ([]global_lb.AddressType, error)
 	GetTemplateDestination(templateNames []string) ([]global_lb.MonitorIPPort, error)
+	GetTemplateDestinationCtx(ctx context.Context, templateNames []string) ([]global_lb.MonitorIPPort, error)
 	GetTemplateIntegerProperty(templateNames []string, propertyTypes []IntPropertyType) ([]IntegerValue, error)
+	GetTemplateIntegerPropertyCtx(ctx context.Context, templateNames []string, propertyTypes []IntPropertyType) ([]IntegerValue, error)
 	GetTemplateState(templateNames []string) ([]common.EnabledState, error)
+	GetTemplateStateCtx(ctx context.Context, templateNames []string) ([]common.EnabledState, error)
 	GetTemplateStringProperty(templateNames []string, propertyTypes []StrPropertyType) ([]StringValue, error)
+	GetTemplateStringPropertyCtx(ctx context.Context, templateNames []string, propertyTypes []StrPropertyType) ([]StringValue, error)
 	GetTemplateUserDefinedStringProperty(templateNames []string, propertyNames []string) ([]UserDefinedStringValue, error)
+	GetTemplateUserDefinedStringPropertyCtx(ctx context.Context, templateNames []string, propertyNames []string) ([]UserDefinedStringValue, error)
 	GetTemplateReverseMode(templateNames []string) ([]bool, error)
+	GetTemplateReverseModeCtx(ctx context.Context, templateNames []string) ([]bool, error)
 	GetTemplateTransparentMode(templateNames []string) ([]bool, error)
+	GetTemplateTransparentModeCtx(ctx context.Context, templateNames []string) ([]bool, error)
 	GetIgnoreDownResponseState(templateNames []string) ([]common.EnabledState, error)
+	GetIgnoreDownResponseStateCtx(ctx context.Context, templateNames []string) ([]common.EnabledState, error)
 }
 
 type MonitorTemplate struct {
-	TemplateName string       // The template name.
-	TemplateType TemplateType // The template type.
+	TemplateName string       `json:"template_name" yaml:"template_name"` // The template name.
+	TemplateType TemplateType `json:"template_type" yaml:"template_type"` // The template type.
 
 }
 
@@ -454,12 +750,17 @@ type getParentTemplateResp struct {
 }
 
 func (m *Monitor) GetParentTemplate(templateNames []string) ([]string, error) {
+	return m.GetParentTemplateCtx(context.Background(), templateNames)
+}
+
+// GetParentTemplateCtx is the context-aware variant of GetParentTemplate.
+func (m *Monitor) GetParentTemplateCtx(ctx context.Context, templateNames []string) ([]string, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetParentTemplateBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetParentTemplateBody{GetParentTemplate: GetParentTemplate{
 			TemplateNames: TemplateNames{
@@ -506,8 +807,13 @@ type getTemplateAddressTypeResp struct {
 }
 
 func (m *Monitor) GetTemplateAddressType(templateNames []string) ([]global_lb.AddressType, error) {
+	return m.GetTemplateAddressTypeCtx(context.Background(), templateNames)
+}
+
+// GetTemplateAddressTypeCtx is the context-aware variant of GetTemplateAddressType.
+func (m *Monitor) GetTemplateAddressTypeCtx(ctx context.Context, templateNames []string) ([]global_lb.AddressType, error) {
 
-	bt, err := m.c.Call(context.Background(), getTemplateAddressTypeReq{
+	bt, err := m.c.Call(ctx, getTemplateAddressTypeReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getTemplateAddressTypeBody{GetTemplateAddressType: getTemplateAddressType{TemplateNames: struct {
 			Item []string `xml:"item"`
@@ -558,8 +864,13 @@ type getTemplateDestinationResp struct {
 }
 
 func (m *Monitor) GetTemplateDestination(templateNames []string) ([]global_lb.MonitorIPPort, error) {
+	return m.GetTemplateDestinationCtx(context.Background(), templateNames)
+}
+
+// GetTemplateDestinationCtx is the context-aware variant of GetTemplateDestination.
+func (m *Monitor) GetTemplateDestinationCtx(ctx context.Context, templateNames []string) ([]global_lb.MonitorIPPort, error) {
 
-	bt, err := m.c.Call(context.Background(), getTemplateDestinationReq{
+	bt, err := m.c.Call(ctx, getTemplateDestinationReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getTemplateDestinationBody{GetTemplateDestination: getTemplateDestination{struct {
 			Item []string `xml:"item"`
@@ -618,8 +929,13 @@ type getTemplateIntegerPropertyResp struct {
 }
 
 func (m *Monitor) GetTemplateIntegerProperty(templateNames []string, propertyTypes []IntPropertyType) ([]IntegerValue, error) {
+	return m.GetTemplateIntegerPropertyCtx(context.Background(), templateNames, propertyTypes)
+}
 
-	bt, err := m.c.Call(context.Background(), getTemplateIntegerPropertyReq{
+// GetTemplateIntegerPropertyCtx is the context-aware variant of GetTemplateIntegerProperty.
+func (m *Monitor) GetTemplateIntegerPropertyCtx(ctx context.Context, templateNames []string, propertyTypes []IntPropertyType) ([]IntegerValue, error) {
+
+	bt, err := m.c.Call(ctx, getTemplateIntegerPropertyReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getTemplateIntegerPropertyBody{GetTemplateIntegerProperty: getTemplateIntegerProperty{
 			TemplateNames: struct {
@@ -662,12 +978,17 @@ type getTemplateStateResp struct {
 }
 
 func (m *Monitor) GetTemplateState(templateNames []string) ([]common.EnabledState, error) {
+	return m.GetTemplateStateCtx(context.Background(), templateNames)
+}
+
+// GetTemplateStateCtx is the context-aware variant of GetTemplateState.
+func (m *Monitor) GetTemplateStateCtx(ctx context.Context, templateNames []string) ([]common.EnabledState, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateStateBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateStateBody{GetTemplateState: GetTemplateState{
 			TemplateNames: TemplateNames{
@@ -712,12 +1033,17 @@ type getTemplateStringPropertyResp struct {
 }
 
 func (m *Monitor) GetTemplateStringProperty(templateNames []string, propertyTypes []StrPropertyType) ([]StringValue, error) {
+	return m.GetTemplateStringPropertyCtx(context.Background(), templateNames, propertyTypes)
+}
+
+// GetTemplateStringPropertyCtx is the context-aware variant of GetTemplateStringProperty.
+func (m *Monitor) GetTemplateStringPropertyCtx(ctx context.Context, templateNames []string, propertyTypes []StrPropertyType) ([]StringValue, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateStringPropertyBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateStringPropertyBody{GetTemplateStringProperty: GetTemplateStringProperty{
 			TemplateNames: TemplateNames{
@@ -765,12 +1091,17 @@ type getTemplateUserDefinedStringPropertyResp struct {
 }
 
 func (m *Monitor) GetTemplateUserDefinedStringProperty(templateNames []string, propertyNames []string) ([]UserDefinedStringValue, error) {
+	return m.GetTemplateUserDefinedStringPropertyCtx(context.Background(), templateNames, propertyNames)
+}
+
+// GetTemplateUserDefinedStringPropertyCtx is the context-aware variant of GetTemplateUserDefinedStringProperty.
+func (m *Monitor) GetTemplateUserDefinedStringPropertyCtx(ctx context.Context, templateNames []string, propertyNames []string) ([]UserDefinedStringValue, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateUserDefinedStringPropertyBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateUserDefinedStringPropertyBody{GetTemplateUserDefinedStringProperty: GetTemplateUserDefinedStringProperty{
 			TemplateNames: TemplateNames{
@@ -827,8 +1158,13 @@ type getTemplateListResp struct {
 }
 
 func (m *Monitor) GetTemplateList() ([]MonitorTemplate, error) {
+	return m.GetTemplateListCtx(context.Background())
+}
+
+// GetTemplateListCtx is the context-aware variant of GetTemplateList.
+func (m *Monitor) GetTemplateListCtx(ctx context.Context) ([]MonitorTemplate, error) {
 
-	bt, err := m.c.Call(context.Background(), getTemplateListReq{
+	bt, err := m.c.Call(ctx, getTemplateListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getTemplateListBody{GetTemplateList: struct{}{}},
 	})
@@ -876,13 +1212,18 @@ type getTemplateTypeResp struct {
 }
 
 func (m *Monitor) GetTemplateType(templateNames []string) ([]TemplateType, error) {
+	return m.GetTemplateTypeCtx(context.Background(), templateNames)
+}
+
+// GetTemplateTypeCtx is the context-aware variant of GetTemplateType.
+func (m *Monitor) GetTemplateTypeCtx(ctx context.Context, templateNames []string) ([]TemplateType, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateTypeBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateTypeBody{GetTemplateType: GetTemplateType{
 			TemplateNames: TemplateNames{
@@ -923,12 +1264,17 @@ type getTemplateReverseModeResp struct {
 }
 
 func (m *Monitor) GetTemplateReverseMode(templateNames []string) ([]bool, error) {
+	return m.GetTemplateReverseModeCtx(context.Background(), templateNames)
+}
+
+// GetTemplateReverseModeCtx is the context-aware variant of GetTemplateReverseMode.
+func (m *Monitor) GetTemplateReverseModeCtx(ctx context.Context, templateNames []string) ([]bool, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateReverseModeBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateReverseModeBody{GetTemplateReverseMode: GetTemplateReverseMode{
 			TemplateNames: TemplateNames{
@@ -968,12 +1314,17 @@ type getTemplateTransparentModeResp struct {
 }
 
 func (m *Monitor) GetTemplateTransparentMode(templateNames []string) ([]bool, error) {
+	return m.GetTemplateTransparentModeCtx(context.Background(), templateNames)
+}
+
+// GetTemplateTransparentModeCtx is the context-aware variant of GetTemplateTransparentMode.
+func (m *Monitor) GetTemplateTransparentModeCtx(ctx context.Context, templateNames []string) ([]bool, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTemplateTransparentModeBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTemplateTransparentModeBody{GetTemplateTransparentMode: GetTemplateTransparentMode{
 			TemplateNames: TemplateNames{
@@ -1013,12 +1364,17 @@ type getIgnoreDownResponseStateResp struct {
 }
 
 func (m *Monitor) GetIgnoreDownResponseState(templateNames []string) ([]common.EnabledState, error) {
+	return m.GetIgnoreDownResponseStateCtx(context.Background(), templateNames)
+}
+
+// GetIgnoreDownResponseStateCtx is the context-aware variant of GetIgnoreDownResponseState.
+func (m *Monitor) GetIgnoreDownResponseStateCtx(ctx context.Context, templateNames []string) ([]common.EnabledState, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetIgnoreDownResponseStateBody `xml:"env:Body"`
 	}
 
-	bt, err := m.c.Call(context.Background(), req{
+	bt, err := m.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetIgnoreDownResponseStateBody{GetIgnoreDownResponseState: GetIgnoreDownResponseState{
 			TemplateNames: TemplateNames{
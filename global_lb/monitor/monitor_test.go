@@ -1,29 +1,57 @@
 package monitor
 
 import (
-	"crypto/tls"
-	"encoding/json"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddMonitors(
+		f5test.Monitor{
+			Name:        "/Common/http_app",
+			Type:        string(TTypeHTTP),
+			Parent:      "/Common/http",
+			AddressType: global_lb.ATypeExplicitAddressExplicitPort,
+			Destination: common.IPPortDefinition{Address: "10.1.1.10", Port: 8080},
+			Integers:    map[string]int64{string(ITypeInterval): 10},
+			Strings: map[string]string{
+				string(STYPE_SEND):    "GET /health HTTP/1.1\\r\\nHost: app\\r\\n\\r\\n",
+				string(STYPE_RECEIVE): "200 OK",
+			},
+			UserDefined: map[string]string{"owner": "ops"},
+			Reverse:     true,
+		},
+		f5test.Monitor{
+			Name:               "/Common/https_app",
+			Type:               string(TTypeHTTPS),
+			Parent:             "/Common/https",
+			Enabled:            common.StateDisabled,
+			IgnoreDownResponse: common.StateEnabled,
+			Transparent:        true,
+		},
 	)
+
+	return s.Client()
 }
 
 func TestMonitor_GetTemplateType(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetTemplateType([]string{"/Common/HTTP-FOSFB", "/Common/HTTPS_APP_RTCP"})
+	arr, err := p.GetTemplateType([]string{"/Common/http_app", "/Common/https_app"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []TemplateType{TTypeHTTP, TTypeHTTPS}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateList(t *testing.T) {
@@ -34,35 +62,42 @@ func TestMonitor_GetTemplateList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	// The built-in templates are listed first.
+	if len(arr) != 6 || arr[4] != (MonitorTemplate{TemplateName: "/Common/http_app", TemplateType: TTypeHTTP}) {
+		t.Fatalf("unexpected templates %+v", arr)
+	}
 }
 
 func TestMonitor_GetParentTemplate(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetParentTemplate([]string{
-		"/Common/HTTP_STATIC_HTML_OCTS-XNV",
-		"/Common/HTTP_PIM-APP",
+		"/Common/http_app",
+		"/Common/https_app",
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []string{"/Common/http", "/Common/https"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateState(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateState([]string{
-		"/Common/HTTP_STATIC_HTML_OCTS-XNV",
-		"/Common/HTTP_PIM-APP",
+		"/Common/http_app",
+		"/Common/https_app",
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateDestination(t *testing.T) {
@@ -70,80 +105,104 @@ func TestMonitor_GetTemplateDestination(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateDestination([]string{
-		"/Common/HTTP_STATIC_HTML_cftpaas4",
-		"/Common/HTTP_STATIC_HTML_OCTS-XNV",
+		"/Common/http_app",
+		"/Common/https_app",
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []global_lb.MonitorIPPort{
+		{AddressType: global_lb.ATypeExplicitAddressExplicitPort, IPPort: common.IPPortDefinition{Address: "10.1.1.10", Port: 8080}},
+		{AddressType: global_lb.ATypeStarAddressStarPort, IPPort: common.IPPortDefinition{Address: "0.0.0.0"}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateStringProperty(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateStringProperty([]string{
-		"/Common/HTTP_STATIC_HTML_cftpaas4",
-		"/Common/HTTP_STATIC_HTML_cftpaas4",
-		"/Common/HTTP_STATIC_HTML_cftpaas4",
-		"/Common/HTTP_STATIC_HTML_cftpaas4",
-		"/Common/HTTPS_APP_SAAS_1",
-		"/Common/HTTPS_APP_SAAS_1",
+		"/Common/http_app",
+		"/Common/http_app",
+		"/Common/http_app",
 		"/Common/tcp",
 	}, []StrPropertyType{
-		STYPE_SEND, STYPE_RECEIVE, STYPE_USERNAME, STYPE_PASSWORD, STYPE_CIPHER_LIST, STYPE_CLIENT_CERTIFICATE, STYPE_SEND,
+		STYPE_SEND, STYPE_RECEIVE, STYPE_USERNAME, STYPE_SEND,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	data, _ := json.Marshal(&arr)
+	want := []StringValue{
+		{Type: STYPE_SEND, Value: "GET /health HTTP/1.1\\r\\nHost: app\\r\\n\\r\\n"},
+		{Type: STYPE_RECEIVE, Value: "200 OK"},
+		{Type: STYPE_USERNAME},
+		{Type: STYPE_SEND},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 
-	t.Logf("%s", data)
+	if _, err := p.GetTemplateStringProperty([]string{"/Common/http_app"}, nil); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
 }
 
 func TestMonitor_GetTemplateUserDefinedStringProperty(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateUserDefinedStringProperty([]string{
-		"/Common/HTTP_STATIC_HTML_OCTS-XNV",
-		"/Common/HTTP_PIM-APP",
+		"/Common/http_app",
+		"/Common/https_app",
 	}, []string{
-		"STYPE_UNSET",
-		"STYPE_SEND",
+		"owner",
+		"owner",
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if len(arr) != 2 {
+		t.Fatalf("expected 2 values, got %+v", arr)
+	}
 }
 
 func TestMonitor_GetTemplateIntegerProperty(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateIntegerProperty(
-		[]string{"/Common/HTTP_STATIC_HTML_cftpaas4", "/Common/HTTP_STATIC_HTML_cftpaas4", "/Common/gateway_icmp"},
+		[]string{"/Common/http_app", "/Common/http_app", "/Common/gateway_icmp"},
 		[]IntPropertyType{ITypeInterval, ITypeTimeOut, "ITYPE_PROBE_ATTEMPTS"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []IntegerValue{
+		{Type: ITypeInterval, Value: 10},
+		{Type: ITypeTimeOut, Value: 120},
+		{Type: "ITYPE_PROBE_ATTEMPTS"},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateReverseMode(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetTemplateReverseMode(
-		[]string{"/Common/HTTP_STATIC_HTML_cftpaas4", "/Common/HTTP_STATIC_HTML_cftpaas4"},
+		[]string{"/Common/http_app", "/Common/https_app"},
 	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []bool{true, false}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestMonitor_GetTemplateTransparentMode(t *testing.T) {
@@ -151,15 +210,16 @@ func TestMonitor_GetTemplateTransparentMode(t *testing.T) {
 
 	arr, err := p.GetTemplateTransparentMode(
 		[]string{
-			"/Common/HTTP_STATIC_HTML_cftpaas4", "/Common/HTTP_SSTS_YXP_80",
-			"/Common/HTTP_OFST-APP-BANK", "/Common/HTTP_STATIC_HTML_GDMS_iaas",
+			"/Common/http_app", "/Common/https_app",
 		},
 	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []bool{false, true}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestMonitor_GetIgnoreDownResponseState(t *testing.T) {
@@ -167,12 +227,18 @@ func TestMonitor_GetIgnoreDownResponseState(t *testing.T) {
 
 	arr, err := p.GetIgnoreDownResponseState(
 		[]string{
-			"/Common/HTTP_STATIC_HTML_cftpaas4", "/Common/HTTP_STATIC_HTML_OCTS-XNV",
+			"/Common/http_app", "/Common/https_app",
 		},
 	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []common.EnabledState{common.StateDisabled, common.StateEnabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if _, err := p.GetIgnoreDownResponseState([]string{"/Common/missing"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
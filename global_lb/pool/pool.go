@@ -3,6 +3,7 @@ package pool
 import (
 	"context"
 	"encoding/xml"
+	"fmt"
 
 	soap "github.com/wule61/go-f5-soap"
 	"github.com/wule61/go-f5-soap/common"
@@ -24,24 +25,85 @@ type PoolNames struct {
 }
 
 type MonitorAssociation struct {
-	PoolName    string                `xml:"pool_name"`
-	MonitorRule global_lb.MonitorRule `xml:"monitor_rule"`
+	PoolName    string                `xml:"pool_name" json:"pool_name" yaml:"pool_name"`
+	MonitorRule global_lb.MonitorRule `xml:"monitor_rule" json:"monitor_rule" yaml:"monitor_rule"`
 }
 
 // IPool The Pool interface enables you to work with pools and their attributes.
 // Introduced : BIG-IP_v9.2.0
 type IPool interface {
 	GetList() (poolNames []string, err error)
+	GetListCtx(ctx context.Context) (poolNames []string, err error)
 	GetMemberV2(poolNames []string) (virtualServerIDs [][]global_lb.VirtualServerID, err error)
+	GetMemberV2Ctx(ctx context.Context, poolNames []string) (virtualServerIDs [][]global_lb.VirtualServerID, err error)
 	GetMemberRatio(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
+	GetMemberRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
 	GetMonitorAssociation(poolNames []string) ([]MonitorAssociation, error)
+	GetMonitorAssociationCtx(ctx context.Context, poolNames []string) ([]MonitorAssociation, error)
 	GetAlternateLBMethod(poolNames []string) ([]string, error)
+	GetAlternateLBMethodCtx(ctx context.Context, poolNames []string) ([]string, error)
 	GetPreferredLBMethod(poolNames []string) ([]string, error)
+	GetPreferredLBMethodCtx(ctx context.Context, poolNames []string) ([]string, error)
 	GetTTL(poolNames []string) ([]int64, error)
+	GetTTLCtx(ctx context.Context, poolNames []string) ([]int64, error)
 	GetVerifyMemberAvailabilityState(poolNames []string) ([]string, error)
+	GetVerifyMemberAvailabilityStateCtx(ctx context.Context, poolNames []string) ([]string, error)
 	GetAnswersToReturn(poolNames []string) ([]int64, error)
+	GetAnswersToReturnCtx(ctx context.Context, poolNames []string) ([]int64, error)
 	GetObjectStatus(poolNames []string) ([]common.ObjectStatus, error)
+	GetObjectStatusCtx(ctx context.Context, poolNames []string) ([]common.ObjectStatus, error)
 	GetEnabledState(poolNames []string) ([]common.EnabledState, error)
+	GetEnabledStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error)
+	GetFallbackLBMethod(poolNames []string) ([]global_lb.LBMethod, error)
+	GetFallbackLBMethodCtx(ctx context.Context, poolNames []string) ([]global_lb.LBMethod, error)
+	GetFallbackIP(poolNames []string) ([]string, error)
+	GetFallbackIPCtx(ctx context.Context, poolNames []string) ([]string, error)
+	GetLimit(poolNames []string) ([]PoolLimit, error)
+	GetLimitCtx(ctx context.Context, poolNames []string) ([]PoolLimit, error)
+	GetQoSCoefficients(poolNames []string) ([]global_lb.QoSCoefficients, error)
+	GetQoSCoefficientsCtx(ctx context.Context, poolNames []string) ([]global_lb.QoSCoefficients, error)
+	GetManualResumeState(poolNames []string) ([]common.EnabledState, error)
+	GetManualResumeStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error)
+	GetDynamicRatioState(poolNames []string) ([]common.EnabledState, error)
+	GetDynamicRatioStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error)
+	GetCNAME(poolNames []string) ([]string, error)
+	GetCNAMECtx(ctx context.Context, poolNames []string) ([]string, error)
+	GetDescription(poolNames []string) ([]string, error)
+	GetDescriptionCtx(ctx context.Context, poolNames []string) ([]string, error)
+	GetAllStatistics() (PoolStatistics, error)
+	GetAllStatisticsCtx(ctx context.Context) (PoolStatistics, error)
+	Create(poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.PoolMemberDefinition) error
+	CreateCtx(ctx context.Context, poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.PoolMemberDefinition) error
+	CreateV2(poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	CreateV2Ctx(ctx context.Context, poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	DeleteAllPools() error
+	DeleteAllPoolsCtx(ctx context.Context) error
+	DeletePool(poolNames []string) error
+	DeletePoolCtx(ctx context.Context, poolNames []string) error
+	AddMemberV2(poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	AddMemberV2Ctx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	RemoveMemberV2(poolNames []string, members [][]global_lb.VirtualServerID) error
+	RemoveMemberV2Ctx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) error
+	SetPreferredLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetPreferredLBMethodCtx(ctx context.Context, poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetAlternateLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetAlternateLBMethodCtx(ctx context.Context, poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetFallbackLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetFallbackLBMethodCtx(ctx context.Context, poolNames []string, lbMethods []global_lb.LBMethod) error
+	SetTTL(poolNames []string, values []int64) error
+	SetTTLCtx(ctx context.Context, poolNames []string, values []int64) error
+	SetAnswersToReturn(poolNames []string, answers []int64) error
+	SetAnswersToReturnCtx(ctx context.Context, poolNames []string, answers []int64) error
+	SetVerifyMemberAvailabilityState(poolNames []string, states []common.EnabledState) error
+	SetVerifyMemberAvailabilityStateCtx(ctx context.Context, poolNames []string, states []common.EnabledState) error
+	SetEnabledState(poolNames []string, states []common.EnabledState) error
+	SetEnabledStateCtx(ctx context.Context, poolNames []string, states []common.EnabledState) error
+	SetMemberRatio(poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error
+	SetMemberRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error
+	SetMonitorAssociation(monitorAssociations []MonitorAssociation) error
+	SetMonitorAssociationCtx(ctx context.Context, monitorAssociations []MonitorAssociation) error
+	RemoveMonitorAssociation(poolNames []string) error
+	RemoveMonitorAssociationCtx(ctx context.Context, poolNames []string) error
 }
 
 var _ IPool = (*Client)(nil)
@@ -91,13 +153,18 @@ type GetMonitorAssociationResp struct {
 }
 
 func (p *Client) GetMonitorAssociation(poolNames []string) ([]MonitorAssociation, error) {
+	return p.GetMonitorAssociationCtx(context.Background(), poolNames)
+}
+
+// GetMonitorAssociationCtx is the context-aware variant of GetMonitorAssociation.
+func (p *Client) GetMonitorAssociationCtx(ctx context.Context, poolNames []string) ([]MonitorAssociation, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetMonitorAssociationBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetMonitorAssociationBody{GetMonitorAssociation: GetMonitorAssociation{
 			PoolNames: PoolNames{
@@ -147,13 +214,18 @@ type ListResp struct {
 }
 
 func (p *Client) GetList() (poolNames []string, err error) {
+	return p.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (p *Client) GetListCtx(ctx context.Context) (poolNames []string, err error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetListBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetListBody{struct{}{}},
 	})
@@ -199,13 +271,18 @@ type GetMemberV2Resp struct {
 }
 
 func (p *Client) GetMemberV2(poolNames []string) (virtualServerIDs [][]global_lb.VirtualServerID, err error) {
+	return p.GetMemberV2Ctx(context.Background(), poolNames)
+}
+
+// GetMemberV2Ctx is the context-aware variant of GetMemberV2.
+func (p *Client) GetMemberV2Ctx(ctx context.Context, poolNames []string) (virtualServerIDs [][]global_lb.VirtualServerID, err error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetMemberV2Body `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetMemberV2Body{GetMemberV2: GetMemberV2{
 			PoolNames: PoolNames{
@@ -249,13 +326,18 @@ type AlternateLbMethodByPoolNamesResp struct {
 }
 
 func (p *Client) GetAlternateLBMethod(poolNames []string) ([]string, error) {
+	return p.GetAlternateLBMethodCtx(context.Background(), poolNames)
+}
+
+// GetAlternateLBMethodCtx is the context-aware variant of GetAlternateLBMethod.
+func (p *Client) GetAlternateLBMethodCtx(ctx context.Context, poolNames []string) ([]string, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetAlternateLbMethodBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetAlternateLbMethodBody{GetAlternateLbMethod{PoolNames{Item: poolNames}}},
 	})
@@ -292,13 +374,18 @@ type PreferredLBMethodByPoolNamesResp struct {
 }
 
 func (p *Client) GetPreferredLBMethod(poolNames []string) ([]string, error) {
+	return p.GetPreferredLBMethodCtx(context.Background(), poolNames)
+}
+
+// GetPreferredLBMethodCtx is the context-aware variant of GetPreferredLBMethod.
+func (p *Client) GetPreferredLBMethodCtx(ctx context.Context, poolNames []string) ([]string, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetPreferredLBMethodBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetPreferredLBMethodBody{GetPreferredLBMethod{PoolNames{Item: poolNames}}},
 	})
@@ -335,13 +422,18 @@ type TTLResp struct {
 }
 
 func (p *Client) GetTTL(poolNames []string) ([]int64, error) {
+	return p.GetTTLCtx(context.Background(), poolNames)
+}
+
+// GetTTLCtx is the context-aware variant of GetTTL.
+func (p *Client) GetTTLCtx(ctx context.Context, poolNames []string) ([]int64, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTTLBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetTTLBody{GetTTL{PoolNames{Item: poolNames}}},
 	})
@@ -377,13 +469,18 @@ type VerifyMemberAvailabilityStateResp struct {
 }
 
 func (p *Client) GetVerifyMemberAvailabilityState(poolNames []string) ([]string, error) {
+	return p.GetVerifyMemberAvailabilityStateCtx(context.Background(), poolNames)
+}
+
+// GetVerifyMemberAvailabilityStateCtx is the context-aware variant of GetVerifyMemberAvailabilityState.
+func (p *Client) GetVerifyMemberAvailabilityStateCtx(ctx context.Context, poolNames []string) ([]string, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetVerifyMemberAvailabilityStateBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetVerifyMemberAvailabilityStateBody{GetVerifyMemberAvailabilityState{PoolNames{Item: poolNames}}},
 	})
@@ -419,13 +516,18 @@ type AnswersToReturnResp struct {
 }
 
 func (p *Client) GetAnswersToReturn(poolNames []string) ([]int64, error) {
+	return p.GetAnswersToReturnCtx(context.Background(), poolNames)
+}
+
+// GetAnswersToReturnCtx is the context-aware variant of GetAnswersToReturn.
+func (p *Client) GetAnswersToReturnCtx(ctx context.Context, poolNames []string) ([]int64, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetAnswersToReturnBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetAnswersToReturnBody{GetAnswersToReturn{PoolNames{Item: poolNames}}},
 	})
@@ -471,13 +573,18 @@ type ObjectStatusResp struct {
 }
 
 func (p *Client) GetObjectStatus(poolNames []string) ([]common.ObjectStatus, error) {
+	return p.GetObjectStatusCtx(context.Background(), poolNames)
+}
+
+// GetObjectStatusCtx is the context-aware variant of GetObjectStatus.
+func (p *Client) GetObjectStatusCtx(ctx context.Context, poolNames []string) ([]common.ObjectStatus, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetObjectStatusBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetObjectStatusBody{GetObjectStatus{PoolNames{Item: poolNames}}},
 	})
@@ -522,13 +629,18 @@ type EnabledStateResp struct {
 }
 
 func (p *Client) GetEnabledState(poolNames []string) ([]common.EnabledState, error) {
+	return p.GetEnabledStateCtx(context.Background(), poolNames)
+}
+
+// GetEnabledStateCtx is the context-aware variant of GetEnabledState.
+func (p *Client) GetEnabledStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetEnabledStateBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetEnabledStateBody{GetEnabledState{PoolNames{Item: poolNames}}},
 	})
@@ -566,6 +678,15 @@ type Item struct {
 	Item []global_lb.VirtualServerID `xml:"item"`
 }
 
+// newMembers returns the members of each pool as an array of arrays.
+func newMembers(members [][]global_lb.VirtualServerID) Members {
+	var res Members
+	for _, v := range members {
+		res.Item = append(res.Item, Item{Item: v})
+	}
+	return res
+}
+
 type getMemberRatioResp struct {
 	XMLName xml.Name `xml:"Envelope"`
 	Body    struct {
@@ -583,19 +704,17 @@ type getMemberRatioResp struct {
 // Introduced : BIG-IP_v11.0.0
 // Gets the ratios for the specified members of the specified pools.
 func (p *Client) GetMemberRatio(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
+	return p.GetMemberRatioCtx(context.Background(), poolNames, members)
+}
 
-	var memberItem []Item
-	for _, v := range members {
-		item := Item{Item: []global_lb.VirtualServerID{}}
-		item.Item = append(item.Item, v...)
-		memberItem = append(memberItem, item)
-	}
+// GetMemberRatioCtx is the context-aware variant of GetMemberRatio.
+func (p *Client) GetMemberRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
 
-	bt, err := p.c.Call(context.Background(), getMemberRatioReq{
+	bt, err := p.c.Call(ctx, getMemberRatioReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getMemberRatioBody{GetMemberRatio: getMemberRatio{
 			PoolNames: PoolNames{Item: poolNames},
-			Members:   Members{Item: memberItem},
+			Members:   newMembers(members),
 		},
 		},
 	})
@@ -615,3 +734,1015 @@ func (p *Client) GetMemberRatio(poolNames []string, members [][]global_lb.Virtua
 
 	return res, nil
 }
+
+type GetFallbackLBMethodBody struct {
+	GetFallbackLBMethod GetFallbackLBMethod `xml:"tns:get_fallback_lb_method"`
+}
+
+type GetFallbackLBMethod struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type FallbackLBMethodResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetFallbackLBMethodResponse struct {
+			Return struct {
+				Item []global_lb.LBMethod `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_fallback_lb_methodResponse"`
+	} `xml:"Body"`
+}
+
+// GetFallbackLBMethod
+// Introduced : BIG-IP_v9.2.0
+// Gets the fallback load balancing methods of the specified pools.
+func (p *Client) GetFallbackLBMethod(poolNames []string) ([]global_lb.LBMethod, error) {
+	return p.GetFallbackLBMethodCtx(context.Background(), poolNames)
+}
+
+// GetFallbackLBMethodCtx is the context-aware variant of GetFallbackLBMethod.
+func (p *Client) GetFallbackLBMethodCtx(ctx context.Context, poolNames []string) ([]global_lb.LBMethod, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetFallbackLBMethodBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetFallbackLBMethodBody{GetFallbackLBMethod{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp FallbackLBMethodResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetFallbackLBMethodResponse.Return.Item, nil
+}
+
+type GetFallbackIPBody struct {
+	GetFallbackIP GetFallbackIP `xml:"tns:get_fallback_ip"`
+}
+
+type GetFallbackIP struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type FallbackIPResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetFallbackIPResponse struct {
+			Return struct {
+				Item []string `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_fallback_ipResponse"`
+	} `xml:"Body"`
+}
+
+// GetFallbackIP
+// Introduced : BIG-IP_v9.2.0
+// Gets the fallback IP addresses of the specified pools, returned by the fallback method LB_METHOD_EXPLICIT_IP.
+func (p *Client) GetFallbackIP(poolNames []string) ([]string, error) {
+	return p.GetFallbackIPCtx(context.Background(), poolNames)
+}
+
+// GetFallbackIPCtx is the context-aware variant of GetFallbackIP.
+func (p *Client) GetFallbackIPCtx(ctx context.Context, poolNames []string) ([]string, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetFallbackIPBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetFallbackIPBody{GetFallbackIP{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp FallbackIPResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetFallbackIPResponse.Return.Item, nil
+}
+
+// PoolLimit are the limits of a pool, which is marked unavailable while one of them is exceeded.
+// A zero value means no limit.
+type PoolLimit = global_lb.Limit
+
+type GetLimitBody struct {
+	GetLimit GetLimit `xml:"tns:get_limit"`
+}
+
+type GetLimit struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type LimitResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetLimitResponse struct {
+			Return struct {
+				Item []struct {
+					Item []global_lb.MetricLimit `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_limitResponse"`
+	} `xml:"Body"`
+}
+
+// GetLimit
+// Introduced : BIG-IP_v9.4.0
+// Gets the limits of the specified pools.
+func (p *Client) GetLimit(poolNames []string) ([]PoolLimit, error) {
+	return p.GetLimitCtx(context.Background(), poolNames)
+}
+
+// GetLimitCtx is the context-aware variant of GetLimit.
+func (p *Client) GetLimitCtx(ctx context.Context, poolNames []string) ([]PoolLimit, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetLimitBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetLimitBody{GetLimit{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp LimitResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res []PoolLimit
+	for _, v := range resp.Body.GetLimitResponse.Return.Item {
+		res = append(res, global_lb.NewLimit(v.Item))
+	}
+
+	return res, nil
+}
+
+type GetQoSCoefficientsBody struct {
+	GetQoSCoefficients GetQoSCoefficients `xml:"tns:get_qos_coefficients"`
+}
+
+type GetQoSCoefficients struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type QoSCoefficientsResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetQoSCoefficientsResponse struct {
+			Return struct {
+				Item []global_lb.QoSCoefficients `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_qos_coefficientsResponse"`
+	} `xml:"Body"`
+}
+
+// GetQoSCoefficients
+// Introduced : BIG-IP_v9.2.0
+// Gets the weights of the metrics used by the Quality of Service load balancing method of the specified pools.
+func (p *Client) GetQoSCoefficients(poolNames []string) ([]global_lb.QoSCoefficients, error) {
+	return p.GetQoSCoefficientsCtx(context.Background(), poolNames)
+}
+
+// GetQoSCoefficientsCtx is the context-aware variant of GetQoSCoefficients.
+func (p *Client) GetQoSCoefficientsCtx(ctx context.Context, poolNames []string) ([]global_lb.QoSCoefficients, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetQoSCoefficientsBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetQoSCoefficientsBody{GetQoSCoefficients{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp QoSCoefficientsResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetQoSCoefficientsResponse.Return.Item, nil
+}
+
+type GetManualResumeStateBody struct {
+	GetManualResumeState GetManualResumeState `xml:"tns:get_manual_resume_state"`
+}
+
+type GetManualResumeState struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type ManualResumeStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetManualResumeStateResponse struct {
+			Return struct {
+				Item []common.EnabledState `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_manual_resume_stateResponse"`
+	} `xml:"Body"`
+}
+
+// GetManualResumeState
+// Introduced : BIG-IP_v9.2.0
+// Gets whether the specified pools stay unavailable after they recover, until they are enabled manually.
+func (p *Client) GetManualResumeState(poolNames []string) ([]common.EnabledState, error) {
+	return p.GetManualResumeStateCtx(context.Background(), poolNames)
+}
+
+// GetManualResumeStateCtx is the context-aware variant of GetManualResumeState.
+func (p *Client) GetManualResumeStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetManualResumeStateBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetManualResumeStateBody{GetManualResumeState{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ManualResumeStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetManualResumeStateResponse.Return.Item, nil
+}
+
+type GetDynamicRatioStateBody struct {
+	GetDynamicRatioState GetDynamicRatioState `xml:"tns:get_dynamic_ratio_state"`
+}
+
+type GetDynamicRatioState struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type DynamicRatioStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetDynamicRatioStateResponse struct {
+			Return struct {
+				Item []common.EnabledState `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_dynamic_ratio_stateResponse"`
+	} `xml:"Body"`
+}
+
+// GetDynamicRatioState
+// Introduced : BIG-IP_v10.0.0
+// Gets whether the specified pools weight the QoS metrics of their members by their ratios.
+func (p *Client) GetDynamicRatioState(poolNames []string) ([]common.EnabledState, error) {
+	return p.GetDynamicRatioStateCtx(context.Background(), poolNames)
+}
+
+// GetDynamicRatioStateCtx is the context-aware variant of GetDynamicRatioState.
+func (p *Client) GetDynamicRatioStateCtx(ctx context.Context, poolNames []string) ([]common.EnabledState, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetDynamicRatioStateBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetDynamicRatioStateBody{GetDynamicRatioState{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DynamicRatioStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetDynamicRatioStateResponse.Return.Item, nil
+}
+
+type GetCNAMEBody struct {
+	GetCNAME GetCNAME `xml:"tns:get_cname"`
+}
+
+type GetCNAME struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type CNAMEResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetCNAMEResponse struct {
+			Return struct {
+				Item []string `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_cnameResponse"`
+	} `xml:"Body"`
+}
+
+// GetCNAME
+// Introduced : BIG-IP_v9.4.0
+// Gets the canonical names returned by the specified pools instead of the addresses of their members.
+func (p *Client) GetCNAME(poolNames []string) ([]string, error) {
+	return p.GetCNAMECtx(context.Background(), poolNames)
+}
+
+// GetCNAMECtx is the context-aware variant of GetCNAME.
+func (p *Client) GetCNAMECtx(ctx context.Context, poolNames []string) ([]string, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetCNAMEBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetCNAMEBody{GetCNAME{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CNAMEResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetCNAMEResponse.Return.Item, nil
+}
+
+type GetDescriptionBody struct {
+	GetDescription GetDescription `xml:"tns:get_description"`
+}
+
+type GetDescription struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+type DescriptionResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetDescriptionResponse struct {
+			Return struct {
+				Item []string `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_descriptionResponse"`
+	} `xml:"Body"`
+}
+
+// GetDescription
+// Introduced : BIG-IP_v11.0.0
+// Gets the descriptions of the specified pools.
+func (p *Client) GetDescription(poolNames []string) ([]string, error) {
+	return p.GetDescriptionCtx(context.Background(), poolNames)
+}
+
+// GetDescriptionCtx is the context-aware variant of GetDescription.
+func (p *Client) GetDescriptionCtx(ctx context.Context, poolNames []string) ([]string, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetDescriptionBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetDescriptionBody{GetDescription{PoolNames{Item: poolNames}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DescriptionResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetDescriptionResponse.Return.Item, nil
+}
+
+// PoolStatisticEntry are the statistics of a pool.
+type PoolStatisticEntry struct {
+	PoolName   string            `xml:"pool_name" json:"pool_name" yaml:"pool_name"`
+	Statistics common.Statistics `xml:"statistics>item" json:"statistics,omitempty" yaml:"statistics,omitempty"`
+}
+
+// PoolStatistics are the statistics of pools, gathered at TimeStamp.
+type PoolStatistics struct {
+	Statistics []PoolStatisticEntry `xml:"statistics>item" json:"statistics,omitempty" yaml:"statistics,omitempty"`
+	TimeStamp  common.TimeStamp     `xml:"time_stamp" json:"time_stamp" yaml:"time_stamp"`
+}
+
+type getAllStatisticsReq struct {
+	soap.BaseEnvEnvelope
+	Body getAllStatisticsBody `xml:"env:Body"`
+}
+
+type getAllStatisticsBody struct {
+	GetAllStatistics struct{} `xml:"tns:get_all_statistics"`
+}
+
+type AllStatisticsResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetAllStatisticsResponse struct {
+			Return PoolStatistics `xml:"return"`
+		} `xml:"get_all_statisticsResponse"`
+	} `xml:"Body"`
+}
+
+// GetAllStatistics
+// Introduced : BIG-IP_v9.2.0
+// Gets the statistics of all pools.
+func (p *Client) GetAllStatistics() (PoolStatistics, error) {
+	return p.GetAllStatisticsCtx(context.Background())
+}
+
+// GetAllStatisticsCtx is the context-aware variant of GetAllStatistics.
+func (p *Client) GetAllStatisticsCtx(ctx context.Context) (PoolStatistics, error) {
+
+	bt, err := p.c.Call(ctx, getAllStatisticsReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+	})
+	if err != nil {
+		return PoolStatistics{}, err
+	}
+
+	var resp AllStatisticsResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return PoolStatistics{}, err
+	}
+
+	return resp.Body.GetAllStatisticsResponse.Return, nil
+}
+
+type lbMethods struct {
+	Item []global_lb.LBMethod `xml:"item"`
+}
+
+type states struct {
+	Item []common.EnabledState `xml:"item"`
+}
+
+type longs struct {
+	Item []int64 `xml:"item"`
+}
+
+type memberDefinitions struct {
+	Item []global_lb.PoolMemberDefinition `xml:"item"`
+}
+
+type createReq struct {
+	soap.BaseEnvEnvelope
+	Body createBody `xml:"env:Body"`
+}
+
+type createBody struct {
+	Create create `xml:"tns:create"`
+}
+
+type create struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	LBMethods lbMethods `xml:"lb_methods"`
+	Members   struct {
+		Item []memberDefinitions `xml:"item"`
+	} `xml:"members"`
+}
+
+// Create
+// Introduced : BIG-IP_v9.2.0
+// Creates the specified pools, with their load balancing methods and their members,
+// the virtual servers listening on the given IP:ports.
+func (p *Client) Create(poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.PoolMemberDefinition) error {
+	return p.CreateCtx(context.Background(), poolNames, lbMethods, members)
+}
+
+// CreateCtx is the context-aware variant of Create.
+func (p *Client) CreateCtx(ctx context.Context, poolNames []string, methods []global_lb.LBMethod, members [][]global_lb.PoolMemberDefinition) error {
+
+	var body create
+	body.PoolNames.Item = poolNames
+	body.LBMethods.Item = methods
+	for i, ms := range members {
+		for j, m := range ms {
+			if err := m.Member.Validate(); err != nil {
+				return fmt.Errorf("members of pool %d: item %d: %w", i, j, err)
+			}
+		}
+		body.Members.Item = append(body.Members.Item, memberDefinitions{Item: ms})
+	}
+
+	_, err := p.c.Call(ctx, createReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            createBody{Create: body},
+	})
+
+	return err
+}
+
+type createV2Req struct {
+	soap.BaseEnvEnvelope
+	Body createV2Body `xml:"env:Body"`
+}
+
+type createV2Body struct {
+	CreateV2 createV2 `xml:"tns:create_v2"`
+}
+
+type createV2 struct {
+	PoolNames PoolNames               `xml:"pool_names"`
+	LBMethods lbMethods               `xml:"lb_methods"`
+	Members   Members                 `xml:"members"`
+	Orders    common.Sequences[int64] `xml:"orders"`
+}
+
+// CreateV2
+// Introduced : BIG-IP_v11.0.0
+// Creates the specified pools, with their load balancing methods and their members,
+// the virtual servers identified by name and server, in the given orders.
+func (p *Client) CreateV2(poolNames []string, lbMethods []global_lb.LBMethod, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+	return p.CreateV2Ctx(context.Background(), poolNames, lbMethods, members, orders)
+}
+
+// CreateV2Ctx is the context-aware variant of CreateV2.
+func (p *Client) CreateV2Ctx(ctx context.Context, poolNames []string, methods []global_lb.LBMethod, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+
+	_, err := p.c.Call(ctx, createV2Req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: createV2Body{CreateV2: createV2{
+			PoolNames: PoolNames{Item: poolNames},
+			LBMethods: lbMethods{Item: methods},
+			Members:   newMembers(members),
+			Orders:    common.NewSequences(orders),
+		}},
+	})
+
+	return err
+}
+
+type deleteAllPoolsReq struct {
+	soap.BaseEnvEnvelope
+	Body deleteAllPoolsBody `xml:"env:Body"`
+}
+
+type deleteAllPoolsBody struct {
+	DeleteAllPools struct{} `xml:"tns:delete_all_pools"`
+}
+
+// DeleteAllPools
+// Introduced : BIG-IP_v9.2.0
+// Deletes all pools.
+func (p *Client) DeleteAllPools() error {
+	return p.DeleteAllPoolsCtx(context.Background())
+}
+
+// DeleteAllPoolsCtx is the context-aware variant of DeleteAllPools.
+func (p *Client) DeleteAllPoolsCtx(ctx context.Context) error {
+
+	_, err := p.c.Call(ctx, deleteAllPoolsReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+	})
+
+	return err
+}
+
+type deletePoolReq struct {
+	soap.BaseEnvEnvelope
+	Body deletePoolBody `xml:"env:Body"`
+}
+
+type deletePoolBody struct {
+	DeletePool deletePool `xml:"tns:delete_pool"`
+}
+
+type deletePool struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+// DeletePool
+// Introduced : BIG-IP_v9.2.0
+// Deletes the specified pools.
+func (p *Client) DeletePool(poolNames []string) error {
+	return p.DeletePoolCtx(context.Background(), poolNames)
+}
+
+// DeletePoolCtx is the context-aware variant of DeletePool.
+func (p *Client) DeletePoolCtx(ctx context.Context, poolNames []string) error {
+
+	_, err := p.c.Call(ctx, deletePoolReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            deletePoolBody{DeletePool: deletePool{PoolNames: PoolNames{Item: poolNames}}},
+	})
+
+	return err
+}
+
+type addMemberV2Req struct {
+	soap.BaseEnvEnvelope
+	Body addMemberV2Body `xml:"env:Body"`
+}
+
+type addMemberV2Body struct {
+	AddMemberV2 addMemberV2 `xml:"tns:add_member_v2"`
+}
+
+type addMemberV2 struct {
+	PoolNames PoolNames               `xml:"pool_names"`
+	Members   Members                 `xml:"members"`
+	Orders    common.Sequences[int64] `xml:"orders"`
+}
+
+// AddMemberV2
+// Introduced : BIG-IP_v11.0.0
+// Adds members to the specified pools, in the given orders.
+func (p *Client) AddMemberV2(poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+	return p.AddMemberV2Ctx(context.Background(), poolNames, members, orders)
+}
+
+// AddMemberV2Ctx is the context-aware variant of AddMemberV2.
+func (p *Client) AddMemberV2Ctx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+
+	_, err := p.c.Call(ctx, addMemberV2Req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: addMemberV2Body{AddMemberV2: addMemberV2{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   newMembers(members),
+			Orders:    common.NewSequences(orders),
+		}},
+	})
+
+	return err
+}
+
+type removeMemberV2Req struct {
+	soap.BaseEnvEnvelope
+	Body removeMemberV2Body `xml:"env:Body"`
+}
+
+type removeMemberV2Body struct {
+	RemoveMemberV2 removeMemberV2 `xml:"tns:remove_member_v2"`
+}
+
+type removeMemberV2 struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	Members   Members   `xml:"members"`
+}
+
+// RemoveMemberV2
+// Introduced : BIG-IP_v11.0.0
+// Removes members from the specified pools.
+func (p *Client) RemoveMemberV2(poolNames []string, members [][]global_lb.VirtualServerID) error {
+	return p.RemoveMemberV2Ctx(context.Background(), poolNames, members)
+}
+
+// RemoveMemberV2Ctx is the context-aware variant of RemoveMemberV2.
+func (p *Client) RemoveMemberV2Ctx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) error {
+
+	_, err := p.c.Call(ctx, removeMemberV2Req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: removeMemberV2Body{RemoveMemberV2: removeMemberV2{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   newMembers(members),
+		}},
+	})
+
+	return err
+}
+
+type setPreferredLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setPreferredLBMethodBody `xml:"env:Body"`
+}
+
+type setPreferredLBMethodBody struct {
+	SetPreferredLBMethod setLBMethod `xml:"tns:set_preferred_lb_method"`
+}
+
+type setLBMethod struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	LBMethods lbMethods `xml:"lb_methods"`
+}
+
+// SetPreferredLBMethod
+// Introduced : BIG-IP_v9.2.0
+// Sets the preferred load balancing methods of the specified pools.
+func (p *Client) SetPreferredLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error {
+	return p.SetPreferredLBMethodCtx(context.Background(), poolNames, lbMethods)
+}
+
+// SetPreferredLBMethodCtx is the context-aware variant of SetPreferredLBMethod.
+func (p *Client) SetPreferredLBMethodCtx(ctx context.Context, poolNames []string, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setPreferredLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setPreferredLBMethodBody{setLBMethod{PoolNames{Item: poolNames}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setAlternateLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setAlternateLBMethodBody `xml:"env:Body"`
+}
+
+type setAlternateLBMethodBody struct {
+	SetAlternateLBMethod setLBMethod `xml:"tns:set_alternate_lb_method"`
+}
+
+// SetAlternateLBMethod
+// Introduced : BIG-IP_v9.2.0
+// Sets the alternate load balancing methods of the specified pools.
+func (p *Client) SetAlternateLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error {
+	return p.SetAlternateLBMethodCtx(context.Background(), poolNames, lbMethods)
+}
+
+// SetAlternateLBMethodCtx is the context-aware variant of SetAlternateLBMethod.
+func (p *Client) SetAlternateLBMethodCtx(ctx context.Context, poolNames []string, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setAlternateLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setAlternateLBMethodBody{setLBMethod{PoolNames{Item: poolNames}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setFallbackLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setFallbackLBMethodBody `xml:"env:Body"`
+}
+
+type setFallbackLBMethodBody struct {
+	SetFallbackLBMethod setLBMethod `xml:"tns:set_fallback_lb_method"`
+}
+
+// SetFallbackLBMethod
+// Introduced : BIG-IP_v9.2.0
+// Sets the fallback load balancing methods of the specified pools.
+func (p *Client) SetFallbackLBMethod(poolNames []string, lbMethods []global_lb.LBMethod) error {
+	return p.SetFallbackLBMethodCtx(context.Background(), poolNames, lbMethods)
+}
+
+// SetFallbackLBMethodCtx is the context-aware variant of SetFallbackLBMethod.
+func (p *Client) SetFallbackLBMethodCtx(ctx context.Context, poolNames []string, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setFallbackLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setFallbackLBMethodBody{setLBMethod{PoolNames{Item: poolNames}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setTTLReq struct {
+	soap.BaseEnvEnvelope
+	Body setTTLBody `xml:"env:Body"`
+}
+
+type setTTLBody struct {
+	SetTTL setTTL `xml:"tns:set_ttl"`
+}
+
+type setTTL struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	Values    longs     `xml:"values"`
+}
+
+// SetTTL
+// Introduced : BIG-IP_v9.2.0
+// Sets the TTLs of the specified pools, in seconds.
+func (p *Client) SetTTL(poolNames []string, values []int64) error {
+	return p.SetTTLCtx(context.Background(), poolNames, values)
+}
+
+// SetTTLCtx is the context-aware variant of SetTTL.
+func (p *Client) SetTTLCtx(ctx context.Context, poolNames []string, values []int64) error {
+
+	_, err := p.c.Call(ctx, setTTLReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setTTLBody{setTTL{PoolNames{Item: poolNames}, longs{Item: values}}},
+	})
+
+	return err
+}
+
+type setAnswersToReturnReq struct {
+	soap.BaseEnvEnvelope
+	Body setAnswersToReturnBody `xml:"env:Body"`
+}
+
+type setAnswersToReturnBody struct {
+	SetAnswersToReturn setAnswersToReturn `xml:"tns:set_answers_to_return"`
+}
+
+type setAnswersToReturn struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	Answers   longs     `xml:"answers"`
+}
+
+// SetAnswersToReturn
+// Introduced : BIG-IP_v10.0.0
+// Sets the number of answers to return of the specified pools.
+func (p *Client) SetAnswersToReturn(poolNames []string, answers []int64) error {
+	return p.SetAnswersToReturnCtx(context.Background(), poolNames, answers)
+}
+
+// SetAnswersToReturnCtx is the context-aware variant of SetAnswersToReturn.
+func (p *Client) SetAnswersToReturnCtx(ctx context.Context, poolNames []string, answers []int64) error {
+
+	_, err := p.c.Call(ctx, setAnswersToReturnReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setAnswersToReturnBody{setAnswersToReturn{PoolNames{Item: poolNames}, longs{Item: answers}}},
+	})
+
+	return err
+}
+
+type setVerifyMemberAvailabilityStateReq struct {
+	soap.BaseEnvEnvelope
+	Body setVerifyMemberAvailabilityStateBody `xml:"env:Body"`
+}
+
+type setVerifyMemberAvailabilityStateBody struct {
+	SetVerifyMemberAvailabilityState setState `xml:"tns:set_verify_member_availability_state"`
+}
+
+type setState struct {
+	PoolNames PoolNames `xml:"pool_names"`
+	States    states    `xml:"states"`
+}
+
+// SetVerifyMemberAvailabilityState
+// Introduced : BIG-IP_v10.0.0
+// Sets whether the specified pools verify the availability of their members.
+func (p *Client) SetVerifyMemberAvailabilityState(poolNames []string, states []common.EnabledState) error {
+	return p.SetVerifyMemberAvailabilityStateCtx(context.Background(), poolNames, states)
+}
+
+// SetVerifyMemberAvailabilityStateCtx is the context-aware variant of SetVerifyMemberAvailabilityState.
+func (p *Client) SetVerifyMemberAvailabilityStateCtx(ctx context.Context, poolNames []string, enabledStates []common.EnabledState) error {
+
+	_, err := p.c.Call(ctx, setVerifyMemberAvailabilityStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setVerifyMemberAvailabilityStateBody{setState{PoolNames{Item: poolNames}, states{Item: enabledStates}}},
+	})
+
+	return err
+}
+
+type setEnabledStateReq struct {
+	soap.BaseEnvEnvelope
+	Body setEnabledStateBody `xml:"env:Body"`
+}
+
+type setEnabledStateBody struct {
+	SetEnabledState setState `xml:"tns:set_enabled_state"`
+}
+
+// SetEnabledState
+// Introduced : BIG-IP_v9.2.0
+// Sets the enabled states of the specified pools.
+func (p *Client) SetEnabledState(poolNames []string, states []common.EnabledState) error {
+	return p.SetEnabledStateCtx(context.Background(), poolNames, states)
+}
+
+// SetEnabledStateCtx is the context-aware variant of SetEnabledState.
+func (p *Client) SetEnabledStateCtx(ctx context.Context, poolNames []string, enabledStates []common.EnabledState) error {
+
+	_, err := p.c.Call(ctx, setEnabledStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setEnabledStateBody{setState{PoolNames{Item: poolNames}, states{Item: enabledStates}}},
+	})
+
+	return err
+}
+
+type setMemberRatioReq struct {
+	soap.BaseEnvEnvelope
+	Body setMemberRatioBody `xml:"env:Body"`
+}
+
+type setMemberRatioBody struct {
+	SetMemberRatio setMemberRatio `xml:"tns:set_member_ratio"`
+}
+
+type setMemberRatio struct {
+	PoolNames PoolNames               `xml:"pool_names"`
+	Members   Members                 `xml:"members"`
+	Ratios    common.Sequences[int64] `xml:"ratios"`
+}
+
+// SetMemberRatio
+// Introduced : BIG-IP_v11.0.0
+// Sets the ratios for the specified members of the specified pools.
+func (p *Client) SetMemberRatio(poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error {
+	return p.SetMemberRatioCtx(context.Background(), poolNames, members, ratios)
+}
+
+// SetMemberRatioCtx is the context-aware variant of SetMemberRatio.
+func (p *Client) SetMemberRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error {
+
+	_, err := p.c.Call(ctx, setMemberRatioReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setMemberRatioBody{SetMemberRatio: setMemberRatio{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   newMembers(members),
+			Ratios:    common.NewSequences(ratios),
+		}},
+	})
+
+	return err
+}
+
+type setMonitorAssociationReq struct {
+	soap.BaseEnvEnvelope
+	Body setMonitorAssociationBody `xml:"env:Body"`
+}
+
+type setMonitorAssociationBody struct {
+	SetMonitorAssociation setMonitorAssociation `xml:"tns:set_monitor_association"`
+}
+
+type setMonitorAssociation struct {
+	MonitorAssociations struct {
+		Item []MonitorAssociation `xml:"item"`
+	} `xml:"monitor_associations"`
+}
+
+// SetMonitorAssociation
+// Introduced : BIG-IP_v9.2.0
+// Sets the monitor rules of the pools of the specified monitor associations.
+func (p *Client) SetMonitorAssociation(monitorAssociations []MonitorAssociation) error {
+	return p.SetMonitorAssociationCtx(context.Background(), monitorAssociations)
+}
+
+// SetMonitorAssociationCtx is the context-aware variant of SetMonitorAssociation.
+func (p *Client) SetMonitorAssociationCtx(ctx context.Context, monitorAssociations []MonitorAssociation) error {
+
+	var body setMonitorAssociation
+	body.MonitorAssociations.Item = monitorAssociations
+
+	_, err := p.c.Call(ctx, setMonitorAssociationReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setMonitorAssociationBody{SetMonitorAssociation: body},
+	})
+
+	return err
+}
+
+type removeMonitorAssociationReq struct {
+	soap.BaseEnvEnvelope
+	Body removeMonitorAssociationBody `xml:"env:Body"`
+}
+
+type removeMonitorAssociationBody struct {
+	RemoveMonitorAssociation removeMonitorAssociation `xml:"tns:remove_monitor_association"`
+}
+
+type removeMonitorAssociation struct {
+	PoolNames PoolNames `xml:"pool_names"`
+}
+
+// RemoveMonitorAssociation
+// Introduced : BIG-IP_v9.2.0
+// Removes the monitor rules of the specified pools.
+func (p *Client) RemoveMonitorAssociation(poolNames []string) error {
+	return p.RemoveMonitorAssociationCtx(context.Background(), poolNames)
+}
+
+// RemoveMonitorAssociationCtx is the context-aware variant of RemoveMonitorAssociation.
+func (p *Client) RemoveMonitorAssociationCtx(ctx context.Context, poolNames []string) error {
+
+	_, err := p.c.Call(ctx, removeMonitorAssociationReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            removeMonitorAssociationBody{removeMonitorAssociation{PoolNames{Item: poolNames}}},
+	})
+
+	return err
+}
@@ -1,18 +1,73 @@
 package pool
 
 import (
-	"crypto/tls"
+	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/transaction"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+	return newServer(t).Client()
+}
+
+func newServer(t *testing.T) *f5test.Server {
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{Name: "vs1", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.0.0.1", Port: 80}},
+		f5test.VirtualServer{Name: "vs2", Server: "/Common/bigip2", Destination: common.IPPortDefinition{Address: "10.0.0.2", Port: 80}},
+		f5test.VirtualServer{Name: "vs3", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.0.0.3", Port: 443}},
+	)
+	s.AddPools(
+		f5test.Pool{
+			Name:              "/Common/pool1",
+			PreferredLBMethod: global_lb.LBMethodRatio,
+			AlternateLBMethod: global_lb.LBMethodTopology,
+			TTL:               60,
+			AnswersToReturn:   2,
+			Monitor: global_lb.MonitorRule{
+				Type:             global_lb.MonitorRuleTypeSingle,
+				MonitorTemplates: []string{"/Common/http"},
+			},
+			Members: []f5test.PoolMember{
+				{Name: "vs1", Server: "/Common/bigip1", Ratio: 2},
+				{Name: "vs2", Server: "/Common/bigip2", Ratio: 3},
+			},
+			FallbackLBMethod: global_lb.LBMethodExplicitIP,
+			FallbackIP:       "192.0.2.1",
+			Limits: []global_lb.MetricLimit{
+				{MetricType: global_lb.MetricLimitBitsPerSecond, Value: 1000000},
+				{MetricType: global_lb.MetricLimitCurrentConnections, Value: 500},
+			},
+			QoS:          global_lb.QoSCoefficients{RTT: 10, Hops: 1, HitRatio: 2, PacketRate: 3, VSCapacity: 4, BPS: 5, LinkCapacity: 6, ConnectionRate: 7, VSScore: 8},
+			ManualResume: common.StateEnabled,
+			DynamicRatio: common.StateEnabled,
+			CNAME:        "www.example.net",
+			Description:  "web servers",
+			Statistics: map[common.StatisticType]uint64{
+				common.StatisticGTMPoolPreferredLBRequests: 1 << 40,
+				common.StatisticGTMPoolReturnedToDNS:       7,
+			},
+		},
+		f5test.Pool{
+			Name:                     "/Common/pool2",
+			VerifyMemberAvailability: common.StateDisabled,
+			Enabled:                  common.StateDisabled,
+			Members: []f5test.PoolMember{
+				{Name: "vs3", Server: "/Common/bigip1"},
+			},
+		},
 	)
+
+	return s
 }
 
 func TestPool_GetAlternateLbMethod(t *testing.T) {
@@ -24,75 +79,96 @@ func TestPool_GetAlternateLbMethod(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []string{"LB_METHOD_TOPOLOGY", "LB_METHOD_ROUND_ROBIN"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetAlternateLBMethod(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetPreferredLBMethod([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetPreferredLBMethod([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []string{"LB_METHOD_RATIO", "LB_METHOD_ROUND_ROBIN"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetTTL(t *testing.T) {
 
 	p := New(newClient(t))
 
-	arr, err := p.GetTTL([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetTTL([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []int64{60, 30}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if _, err := p.GetTTL([]string{"/Common/pool1", "/Common/aaaa"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
 
 func TestPool_GetVerifyMemberAvailabilityState(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetVerifyMemberAvailabilityState([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetVerifyMemberAvailabilityState([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []string{"STATE_ENABLED", "STATE_DISABLED"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetAnswersToReturn(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetAnswersToReturn([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetAnswersToReturn([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []int64{2, 1}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetObjectStatus(t *testing.T) {
 
 	p := New(newClient(t))
 
-	arr, err := p.GetObjectStatus([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetObjectStatus([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if len(arr) != 2 {
+		t.Fatalf("expected 2 statuses, got %+v", arr)
+	}
+	if arr[0].AvailabilityStatus != common.AvailabilityStatusGreen || arr[1].EnabledStatus != common.EnabledStatusDisabled {
+		t.Fatalf("unexpected statuses %+v", arr)
+	}
 }
 
 func TestPool_GetEnabledState(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetEnabledState([]string{"/Common/pool1", "/Common/pool2", "/Common/aaaa"})
+	arr, err := p.GetEnabledState([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetList(t *testing.T) {
@@ -104,30 +180,47 @@ func TestPool_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []string{"/Common/pool1", "/Common/pool2"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPool_GetMemberV2(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetMemberV2([]string{"/Common/boom-ac-29-vip_pool", "/Common/boom-ac-28-vip_pool"})
+	arr, err := p.GetMemberV2([]string{"/Common/pool1", "/Common/pool2"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := [][]global_lb.VirtualServerID{
+		{{Name: "vs1", Server: "/Common/bigip1"}, {Name: "vs2", Server: "/Common/bigip2"}},
+		{{Name: "vs3", Server: "/Common/bigip1"}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestPool_GetMonitorAssociation(t *testing.T) {
 
 	p := New(newClient(t))
 
-	arr, err := p.GetMonitorAssociation([]string{"/Common/A1_UMSP-APP-BAT-NO_pool"})
+	arr, err := p.GetMonitorAssociation([]string{"/Common/pool1"})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []MonitorAssociation{{
+		PoolName: "/Common/pool1",
+		MonitorRule: global_lb.MonitorRule{
+			Type:             global_lb.MonitorRuleTypeSingle,
+			MonitorTemplates: []string{"/Common/http"},
+		},
+	}}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestPool_GetMemberRatio(t *testing.T) {
@@ -135,15 +228,14 @@ func TestPool_GetMemberRatio(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetMemberRatio(
-		[]string{"/Common/boom-ac-29-vip_pool", "/Common/boom-ac-28-vip_pool"},
+		[]string{"/Common/pool1", "/Common/pool2"},
 		[][]global_lb.VirtualServerID{
 			{
-				{Name: "vs_76_147_241_195_3306", Server: "/Common/boom-ac-29-vip_76.147.241.195"},
-				{Name: "vs_76_147_241_196_3306", Server: "/Common/boom-ac-29-vip_76.147.241.196"},
+				{Name: "vs1", Server: "/Common/bigip1"},
+				{Name: "vs2", Server: "/Common/bigip2"},
 			},
 			{
-				{Name: "vs_76_147_225_195_3306", Server: "/Common/boom-ac-28-vip_76.147.225.195"},
-				{Name: "vs_76_147_232_195_3306", Server: "//Common/boom-ac-28-vip_76.147.232.195"},
+				{Name: "vs3", Server: "/Common/bigip1"},
 			},
 		},
 	)
@@ -151,5 +243,598 @@ func TestPool_GetMemberRatio(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := [][]int64{{2, 3}, {1}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_Create(t *testing.T) {
+
+	s := newServer(t)
+	p := New(s.Client())
+
+	err := p.Create(
+		[]string{"/Common/pool3"},
+		[]global_lb.LBMethod{global_lb.LBMethodGlobalAvailability},
+		[][]global_lb.PoolMemberDefinition{{
+			{Member: common.IPPortDefinition{Address: "10.0.0.3", Port: 443}, Order: 1},
+			{Member: common.IPPortDefinition{Address: "10.0.0.1", Port: 80}, Order: 0},
+		}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := s.Pool("/Common/pool3", "")
+	if !ok {
+		t.Fatal("expected pool3 to be created")
+	}
+	if got.PreferredLBMethod != global_lb.LBMethodGlobalAvailability {
+		t.Fatalf("expected %s, got %s", global_lb.LBMethodGlobalAvailability, got.PreferredLBMethod)
+	}
+	want := []f5test.PoolMember{
+		{Name: "vs3", Server: "/Common/bigip1", Ratio: 1, Order: 1, Enabled: common.StateEnabled},
+		{Name: "vs1", Server: "/Common/bigip1", Ratio: 1, Order: 0, Enabled: common.StateEnabled},
+	}
+	if !reflect.DeepEqual(got.Members, want) {
+		t.Fatalf("expected %+v, got %+v", want, got.Members)
+	}
+
+	if err := p.Create([]string{"/Common/pool1"}, []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, [][]global_lb.PoolMemberDefinition{nil}); !soap.IsAlreadyExists(err) {
+		t.Fatalf("expected an already exists fault, got %v", err)
+	}
+
+	bad := [][]global_lb.PoolMemberDefinition{{{Member: common.IPPortDefinition{Address: "10.0.0", Port: 80}}}}
+	if err := p.Create([]string{"/Common/pool4"}, []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, bad); !errors.Is(err, common.ErrInvalidAddress) {
+		t.Fatalf("expected ErrInvalidAddress, got %v", err)
+	}
+}
+
+func TestPool_CreateV2(t *testing.T) {
+
+	p := New(newClient(t))
+
+	err := p.CreateV2(
+		[]string{"/Common/pool3", "/Common/pool4"},
+		[]global_lb.LBMethod{global_lb.LBMethodRatio, global_lb.LBMethodTopology},
+		[][]global_lb.VirtualServerID{
+			{{Name: "vs1", Server: "/Common/bigip1"}, {Name: "vs2", Server: "/Common/bigip2"}},
+			{},
+		},
+		[][]int64{{0, 1}, {}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, err := p.GetMemberV2([]string{"/Common/pool3", "/Common/pool4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]global_lb.VirtualServerID{
+		{{Name: "vs1", Server: "/Common/bigip1"}, {Name: "vs2", Server: "/Common/bigip2"}},
+		nil,
+	}
+	if !reflect.DeepEqual(members, want) {
+		t.Fatalf("expected %+v, got %+v", want, members)
+	}
+
+	methods, err := p.GetPreferredLBMethod([]string{"/Common/pool3", "/Common/pool4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"LB_METHOD_RATIO", "LB_METHOD_TOPOLOGY"}; !reflect.DeepEqual(methods, want) {
+		t.Fatalf("expected %v, got %v", want, methods)
+	}
+
+	err = p.CreateV2(
+		[]string{"/Common/pool5"},
+		[]global_lb.LBMethod{global_lb.LBMethodRatio},
+		[][]global_lb.VirtualServerID{{{Name: "vs9", Server: "/Common/bigip1"}}},
+		[][]int64{{0}},
+	)
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPool_DeletePool(t *testing.T) {
+
+	p := New(newClient(t))
+
+	if err := p.DeletePool([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"/Common/pool2"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	// Nothing is deleted when one of the pools is missing.
+	if err := p.DeletePool([]string{"/Common/pool2", "/Common/pool1"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if arr, _ := p.GetList(); len(arr) != 1 {
+		t.Fatalf("expected pool2 to be kept, got %v", arr)
+	}
+}
+
+func TestPool_DeleteAllPools(t *testing.T) {
+
+	p := New(newClient(t))
+
+	if err := p.DeleteAllPools(); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 0 {
+		t.Fatalf("expected no pools, got %v", arr)
+	}
+}
+
+func TestPool_AddMemberV2(t *testing.T) {
+
+	s := newServer(t)
+	p := New(s.Client())
+
+	err := p.AddMemberV2(
+		[]string{"/Common/pool2"},
+		[][]global_lb.VirtualServerID{{{Name: "vs2", Server: "/Common/bigip2"}}},
+		[][]int64{{3}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := s.Pool("/Common/pool2", "")
+	want := []f5test.PoolMember{
+		{Name: "vs3", Server: "/Common/bigip1", Ratio: 1, Enabled: common.StateEnabled},
+		{Name: "vs2", Server: "/Common/bigip2", Ratio: 1, Order: 3, Enabled: common.StateEnabled},
+	}
+	if !reflect.DeepEqual(got.Members, want) {
+		t.Fatalf("expected %+v, got %+v", want, got.Members)
+	}
+
+	err = p.AddMemberV2(
+		[]string{"/Common/pool2"},
+		[][]global_lb.VirtualServerID{{{Name: "vs2", Server: "/Common/bigip2"}}},
+		[][]int64{{3}},
+	)
+	if !soap.IsAlreadyExists(err) {
+		t.Fatalf("expected an already exists fault, got %v", err)
+	}
+}
+
+func TestPool_RemoveMemberV2(t *testing.T) {
+
+	p := New(newClient(t))
+
+	err := p.RemoveMemberV2(
+		[]string{"/Common/pool1"},
+		[][]global_lb.VirtualServerID{{{Name: "vs1", Server: "/Common/bigip1"}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMemberV2([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]global_lb.VirtualServerID{{{Name: "vs2", Server: "/Common/bigip2"}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	err = p.RemoveMemberV2(
+		[]string{"/Common/pool1"},
+		[][]global_lb.VirtualServerID{{{Name: "vs1", Server: "/Common/bigip1"}}},
+	)
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPool_SetLBMethod(t *testing.T) {
+
+	s := newServer(t)
+	p := New(s.Client())
+	names := []string{"/Common/pool1", "/Common/pool2"}
+
+	if err := p.SetPreferredLBMethod(names, []global_lb.LBMethod{global_lb.LBMethodTopology, global_lb.LBMethodRatio}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetAlternateLBMethod(names, []global_lb.LBMethod{global_lb.LBMethodNULL, global_lb.LBMethodStaticPersist}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetFallbackLBMethod(names, []global_lb.LBMethod{global_lb.LBMethodReturnToDNS, global_lb.LBMethodDropPacket}); err != nil {
+		t.Fatal(err)
+	}
+
+	preferred, err := p.GetPreferredLBMethod(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"LB_METHOD_TOPOLOGY", "LB_METHOD_RATIO"}; !reflect.DeepEqual(preferred, want) {
+		t.Fatalf("expected %v, got %v", want, preferred)
+	}
+
+	alternate, err := p.GetAlternateLBMethod(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"LB_METHOD_NULL", "LB_METHOD_STATIC_PERSIST"}; !reflect.DeepEqual(alternate, want) {
+		t.Fatalf("expected %v, got %v", want, alternate)
+	}
+
+	pool2, _ := s.Pool("/Common/pool2", "")
+	if pool2.FallbackLBMethod != global_lb.LBMethodDropPacket {
+		t.Fatalf("expected %s, got %s", global_lb.LBMethodDropPacket, pool2.FallbackLBMethod)
+	}
+
+	if err := p.SetPreferredLBMethod(names, []global_lb.LBMethod{"LB_METHOD_NO_SUCH_METHOD", global_lb.LBMethodRatio}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPool_SetTTL(t *testing.T) {
+
+	p := New(newClient(t))
+	names := []string{"/Common/pool1", "/Common/pool2"}
+
+	if err := p.SetTTL(names, []int64{300, 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetTTL(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{300, 5}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetTTL(names, []int64{60}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+	if err := p.SetTTL([]string{"/Common/pool1", "/Common/aaaa"}, []int64{1, 1}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if arr, _ := p.GetTTL(names); !reflect.DeepEqual(arr, []int64{300, 5}) {
+		t.Fatalf("expected the ttls to be kept, got %v", arr)
+	}
+}
+
+func TestPool_SetAnswersToReturn(t *testing.T) {
+
+	p := New(newClient(t))
+	names := []string{"/Common/pool1", "/Common/pool2"}
+
+	if err := p.SetAnswersToReturn(names, []int64{1, 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetAnswersToReturn(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1, 4}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_SetVerifyMemberAvailabilityState(t *testing.T) {
+
+	p := New(newClient(t))
+	names := []string{"/Common/pool1", "/Common/pool2"}
+
+	if err := p.SetVerifyMemberAvailabilityState(names, []common.EnabledState{common.StateDisabled, common.StateEnabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetVerifyMemberAvailabilityState(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"STATE_DISABLED", "STATE_ENABLED"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_SetEnabledState(t *testing.T) {
+
+	p := New(newClient(t))
+	names := []string{"/Common/pool1", "/Common/pool2"}
+
+	if err := p.SetEnabledState(names, []common.EnabledState{common.StateDisabled, common.StateEnabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetEnabledState(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []common.EnabledState{common.StateDisabled, common.StateEnabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	status, err := p.GetObjectStatus(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status[0].EnabledStatus != common.EnabledStatusDisabled || status[1].EnabledStatus != common.EnabledStatusEnabled {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestPool_SetMemberRatio(t *testing.T) {
+
+	p := New(newClient(t))
+	names := []string{"/Common/pool1", "/Common/pool2"}
+	members := [][]global_lb.VirtualServerID{
+		{{Name: "vs2", Server: "/Common/bigip2"}},
+		{{Name: "vs3", Server: "/Common/bigip1"}},
+	}
+
+	if err := p.SetMemberRatio(names, members, [][]int64{{10}, {7}}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMemberRatio(names, [][]global_lb.VirtualServerID{
+		{{Name: "vs1", Server: "/Common/bigip1"}, {Name: "vs2", Server: "/Common/bigip2"}},
+		{{Name: "vs3", Server: "/Common/bigip1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int64{{2, 10}, {7}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetMemberRatio(names, members, [][]int64{{10, 1}, {7}}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPool_SetMonitorAssociation(t *testing.T) {
+
+	p := New(newClient(t))
+
+	want := []MonitorAssociation{{
+		PoolName: "/Common/pool2",
+		MonitorRule: global_lb.MonitorRule{
+			Type:             global_lb.MonitorRuleTypeMOfN,
+			Quorum:           1,
+			MonitorTemplates: []string{"/Common/http", "/Common/tcp"},
+		},
+	}}
+	if err := p.SetMonitorAssociation(want); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMonitorAssociation([]string{"/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	missing := []MonitorAssociation{{
+		PoolName:    "/Common/pool2",
+		MonitorRule: global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/aaaa"}},
+	}}
+	if err := p.SetMonitorAssociation(missing); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPool_RemoveMonitorAssociation(t *testing.T) {
+
+	p := New(newClient(t))
+
+	if err := p.RemoveMonitorAssociation([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMonitorAssociation([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []MonitorAssociation{{
+		PoolName:    "/Common/pool1",
+		MonitorRule: global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeNone},
+	}}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+}
+
+func TestPool_Transaction(t *testing.T) {
+
+	s := newServer(t)
+	c := s.Client()
+
+	tx := transaction.New(c)
+	tx.Queue("create pool3", func(ctx context.Context, c *soap.Client) error {
+		return New(c).CreateV2Ctx(ctx, []string{"/Common/pool3"}, []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, [][]global_lb.VirtualServerID{{}}, [][]int64{{}})
+	})
+	tx.Queue("set ttl", func(ctx context.Context, c *soap.Client) error {
+		return New(c).SetTTLCtx(ctx, []string{"/Common/pool3"}, []int64{120})
+	})
+	if err := tx.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, ok := s.Pool("/Common/pool3", ""); !ok || got.TTL != 120 {
+		t.Fatalf("expected pool3 with a ttl of 120, got %+v", got)
+	}
+
+	// The changes queued before a failing one are not applied.
+	tx = transaction.New(c)
+	tx.Queue("delete pool1", func(ctx context.Context, c *soap.Client) error {
+		return New(c).DeletePoolCtx(ctx, []string{"/Common/pool1"})
+	})
+	tx.Queue("delete missing", func(ctx context.Context, c *soap.Client) error {
+		return New(c).DeletePoolCtx(ctx, []string{"/Common/aaaa"})
+	})
+	if err := tx.Commit(context.Background()); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if _, ok := s.Pool("/Common/pool1", ""); !ok {
+		t.Fatal("expected pool1 to be kept")
+	}
+}
+
+func TestPool_GetFallbackLBMethod(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetFallbackLBMethod([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []global_lb.LBMethod{global_lb.LBMethodExplicitIP, global_lb.LBMethodReturnToDNS}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_GetFallbackIP(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetFallbackIP([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"192.0.2.1", ""}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %q, got %q", want, arr)
+	}
+}
+
+func TestPool_GetLimit(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetLimit([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []PoolLimit{{BitsPerSecond: 1000000, CurrentConnections: 500}, {}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+}
+
+func TestPool_GetQoSCoefficients(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetQoSCoefficients([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []global_lb.QoSCoefficients{
+		{RTT: 10, Hops: 1, HitRatio: 2, PacketRate: 3, VSCapacity: 4, BPS: 5, LinkCapacity: 6, ConnectionRate: 7, VSScore: 8},
+		{RTT: 50, HitRatio: 5, PacketRate: 1, BPS: 3, LinkCapacity: 30},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+}
+
+func TestPool_GetManualResumeState(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetManualResumeState([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_GetDynamicRatioState(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetDynamicRatioState([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPool_GetCNAME(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetCNAME([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"www.example.net", ""}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %q, got %q", want, arr)
+	}
+}
+
+func TestPool_GetDescription(t *testing.T) {
+
+	p := New(newClient(t))
+
+	arr, err := p.GetDescription([]string{"/Common/pool1", "/Common/pool2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"web servers", ""}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %q, got %q", want, arr)
+	}
+
+	if _, err := p.GetDescription([]string{"/Common/aaaa"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPool_GetAllStatistics(t *testing.T) {
+
+	p := New(newClient(t))
+
+	stats, err := p.GetAllStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats.Statistics) != 2 || stats.Statistics[0].PoolName != "/Common/pool1" || stats.Statistics[1].PoolName != "/Common/pool2" {
+		t.Fatalf("unexpected statistics %+v", stats.Statistics)
+	}
+	if v, ok := stats.Statistics[0].Statistics.Value(common.StatisticGTMPoolPreferredLBRequests); !ok || v != 1<<40 {
+		t.Fatalf("expected %d preferred requests, got %d", uint64(1<<40), v)
+	}
+	if v, ok := stats.Statistics[0].Statistics.Value(common.StatisticGTMPoolReturnedToDNS); !ok || v != 7 {
+		t.Fatalf("expected 7 requests returned to DNS, got %d", v)
+	}
+	if v, ok := stats.Statistics[1].Statistics.Value(common.StatisticGTMPoolPreferredLBRequests); !ok || v != 0 {
+		t.Fatalf("expected no preferred requests, got %d", v)
+	}
+	if stats.TimeStamp.Time(time.UTC).IsZero() || stats.TimeStamp.Year < 2000 {
+		t.Fatalf("unexpected time stamp %+v", stats.TimeStamp)
+	}
 }
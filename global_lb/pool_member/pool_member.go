@@ -3,6 +3,7 @@ package pool_member
 import (
 	"context"
 	"encoding/xml"
+	"fmt"
 
 	soap "github.com/wule61/go-f5-soap"
 	"github.com/wule61/go-f5-soap/common"
@@ -16,7 +17,9 @@ const tns = "urn:iControl:GlobalLB/PoolMember"
 // The PoolMember interface enables you to work with the pool members and their settings, and statistics.
 type IPoolMember interface {
 	GetRatio(poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberRatio, error)
+	GetRatioCtx(ctx context.Context, poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberRatio, error)
 	GetObjectStatus(poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberObjectStatus, error)
+	GetObjectStatusCtx(ctx context.Context, poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberObjectStatus, error)
 }
 
 var _ IPoolMember = (*PoolMember)(nil)
@@ -80,12 +83,21 @@ type RatioResp struct {
 // GetRatio Gets the ratios for the specified members in the specified pools.
 // 获取指定池中指定成员的比率。
 func (p *PoolMember) GetRatio(poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberRatio, error) {
+	return p.GetRatioCtx(context.Background(), poolNames, members)
+}
+
+// GetRatioCtx is the context-aware variant of GetRatio.
+func (p *PoolMember) GetRatioCtx(ctx context.Context, poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberRatio, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetRatioBody `xml:"env:Body"`
 	}
 
+	if err := validate(members); err != nil {
+		return nil, err
+	}
+
 	var reqItem []Item
 	for _, v := range members {
 		item := Item{Item: []common.IPPortDefinition{}}
@@ -93,7 +105,7 @@ func (p *PoolMember) GetRatio(poolNames []string, members [][]common.IPPortDefin
 		reqItem = append(reqItem, item)
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetRatioBody{GetRatio: GetRatio{
 			PoolNames: PoolNames{Item: poolNames},
@@ -172,12 +184,21 @@ type ObjectStatusResp struct {
 // GetObjectStatus Gets the statuses for the specified members in the specified pools.
 // 获取指定池中指定成员的状态。
 func (p *PoolMember) GetObjectStatus(poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberObjectStatus, error) {
+	return p.GetObjectStatusCtx(context.Background(), poolNames, members)
+}
+
+// GetObjectStatusCtx is the context-aware variant of GetObjectStatus.
+func (p *PoolMember) GetObjectStatusCtx(ctx context.Context, poolNames []string, members [][]common.IPPortDefinition) ([][]common.MemberObjectStatus, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetObjectStatusBody `xml:"env:Body"`
 	}
 
+	if err := validate(members); err != nil {
+		return nil, err
+	}
+
 	var reqItem []Item
 	for _, v := range members {
 		item := Item{Item: []common.IPPortDefinition{}}
@@ -185,7 +206,7 @@ func (p *PoolMember) GetObjectStatus(poolNames []string, members [][]common.IPPo
 		reqItem = append(reqItem, item)
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetObjectStatusBody{GetObjectStatus: GetObjectStatus{
 			PoolNames: PoolNames{Item: poolNames},
@@ -223,3 +244,13 @@ func (p *PoolMember) GetObjectStatus(poolNames []string, members [][]common.IPPo
 	return res, nil
 
 }
+
+// validate checks the addresses of members before they are sent to the device.
+func validate(members [][]common.IPPortDefinition) error {
+	for i, ms := range members {
+		if err := common.ValidateIPPorts(ms...); err != nil {
+			return fmt.Errorf("members of pool %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -1,18 +1,31 @@
 package pool_member
 
 import (
-	"crypto/tls"
+	"errors"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
 	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.1.101.101/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{Name: "vs1", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "1.2.3.4", Port: 22}},
+		f5test.VirtualServer{Name: "vs2", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.2.5.5", Port: 0}},
 	)
+	s.AddPools(f5test.Pool{
+		Name: "/Common/pool2",
+		Members: []f5test.PoolMember{
+			{Name: "vs1", Server: "/Common/bigip1", Ratio: 4},
+			{Name: "vs2", Server: "/Common/bigip1", Enabled: common.StateDisabled},
+		},
+	})
+
+	return s.Client()
 }
 
 func TestPoolMember_GetRatio(t *testing.T) {
@@ -29,7 +42,13 @@ func TestPoolMember_GetRatio(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v\n", res)
+	want := [][]common.MemberRatio{{
+		{Member: common.IPPortDefinition{Address: "1.2.3.4", Port: 22}, Ratio: 4},
+		{Member: common.IPPortDefinition{Address: "10.2.5.5", Port: 0}, Ratio: 1},
+	}}
+	if !reflect.DeepEqual(res, want) {
+		t.Fatalf("expected %+v, got %+v", want, res)
+	}
 }
 
 func TestPoolMember_GetObjectStatus(t *testing.T) {
@@ -45,5 +64,43 @@ func TestPoolMember_GetObjectStatus(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v\n", res)
+	if len(res) != 1 || len(res[0]) != 2 {
+		t.Fatalf("unexpected statuses %+v", res)
+	}
+	if res[0][0].Status.EnabledStatus != common.EnabledStatusEnabled || res[0][1].Status.EnabledStatus != common.EnabledStatusDisabled {
+		t.Fatalf("unexpected statuses %+v", res)
+	}
+}
+
+func TestPoolMember_GetRatio_IPv6(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{Name: "vs6", Server: "/Tenant/bigip1", Destination: common.IPPortDefinition{Address: "2001:db8::1%2", Port: 443}},
+	)
+	s.AddPools(f5test.Pool{
+		Name:    "/Tenant/pool6",
+		Members: []f5test.PoolMember{{Name: "vs6", Server: "/Tenant/bigip1", Ratio: 3}},
+	})
+	p := New(s.Client())
+
+	member, err := common.ParseIPPort("2001:DB8:0::1%2.443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.GetRatio([]string{"/Tenant/pool6"}, [][]common.IPPortDefinition{{member}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || len(res[0]) != 1 || res[0][0].Ratio != 3 {
+		t.Fatalf("unexpected ratios %+v", res)
+	}
+
+	_, err = p.GetRatio([]string{"/Tenant/pool6"}, [][]common.IPPortDefinition{{{Address: "2001:db8::1%x", Port: 443}}})
+	if !errors.Is(err, common.ErrInvalidAddress) {
+		t.Fatalf("expected ErrInvalidAddress, got %v", err)
+	}
+	if n := len(s.Calls()); n != 1 {
+		t.Fatalf("expected the invalid member not to be sent, got %d calls", n)
+	}
 }
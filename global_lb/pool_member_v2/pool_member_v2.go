@@ -0,0 +1,895 @@
+package pool_member_v2
+
+import (
+	"context"
+	"encoding/xml"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/global_lb"
+)
+
+// The pool member operations keyed by virtual server are served by the Pool interface since BIG-IP v11.
+const tns = "urn:iControl:GlobalLB/Pool"
+
+// IPoolMemberV2 The PoolMemberV2 interface enables you to work with the members of pools and their settings,
+// and statistics. Unlike the deprecated PoolMember interface, the members are identified by the virtual
+// servers they stand for, which remain stable when the address of a virtual server changes.
+// To drain a member, disable it, poll its current connections with GetStatistics until they reach zero,
+// then re-enable it.
+type IPoolMemberV2 interface {
+	GetEnabledState(poolNames []string, members [][]global_lb.VirtualServerID) ([][]common.EnabledState, error)
+	GetEnabledStateCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]common.EnabledState, error)
+	SetEnabledState(poolNames []string, members [][]global_lb.VirtualServerID, states [][]common.EnabledState) error
+	SetEnabledStateCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, states [][]common.EnabledState) error
+	GetRatio(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
+	GetRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
+	SetRatio(poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error
+	SetRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error
+	GetOrder(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
+	GetOrderCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error)
+	SetOrder(poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	SetOrderCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error
+	GetLimit(poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.Limit, error)
+	GetLimitCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.Limit, error)
+	SetLimit(poolNames []string, members [][]global_lb.VirtualServerID, limits [][]global_lb.Limit) error
+	SetLimitCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, limits [][]global_lb.Limit) error
+	GetMonitorRule(poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.MonitorRule, error)
+	GetMonitorRuleCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.MonitorRule, error)
+	SetMonitorRule(poolNames []string, members [][]global_lb.VirtualServerID, monitorRules [][]global_lb.MonitorRule) error
+	SetMonitorRuleCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, monitorRules [][]global_lb.MonitorRule) error
+	GetDependency(poolNames []string, members [][]global_lb.VirtualServerID) ([][][]global_lb.VirtualServerID, error)
+	GetDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][][]global_lb.VirtualServerID, error)
+	AddDependency(poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error
+	AddDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error
+	RemoveDependency(poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error
+	RemoveDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error
+	RemoveAllDependencies(poolNames []string, members [][]global_lb.VirtualServerID) error
+	RemoveAllDependenciesCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) error
+	GetDescription(poolNames []string, members [][]global_lb.VirtualServerID) ([][]string, error)
+	GetDescriptionCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]string, error)
+	SetDescription(poolNames []string, members [][]global_lb.VirtualServerID, descriptions [][]string) error
+	SetDescriptionCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, descriptions [][]string) error
+	GetStatistics(poolNames []string, members [][]global_lb.VirtualServerID) ([]MemberStatistics, error)
+	GetStatisticsCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([]MemberStatistics, error)
+}
+
+var _ IPoolMemberV2 = (*PoolMemberV2)(nil)
+
+type PoolMemberV2 struct {
+	c *soap.Client
+}
+
+func New(c *soap.Client) *PoolMemberV2 {
+	return &PoolMemberV2{
+		c: c,
+	}
+}
+
+type PoolNames struct {
+	Item []string `xml:"item"`
+}
+
+// MemberStatisticEntry are the statistics of a pool member.
+type MemberStatisticEntry struct {
+	Member     global_lb.VirtualServerID `xml:"member" json:"member" yaml:"member"`
+	Statistics common.Statistics         `xml:"statistics>item" json:"statistics,omitempty" yaml:"statistics,omitempty"`
+}
+
+// MemberStatistics are the statistics of the members of a pool, gathered at TimeStamp.
+type MemberStatistics struct {
+	Statistics []MemberStatisticEntry `xml:"statistics>item" json:"statistics,omitempty" yaml:"statistics,omitempty"`
+	TimeStamp  common.TimeStamp       `xml:"time_stamp" json:"time_stamp" yaml:"time_stamp"`
+}
+
+type GetEnabledStateBody struct {
+	GetEnabledState GetEnabledState `xml:"tns:get_member_enabled_state"`
+}
+
+type GetEnabledState struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type EnabledStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetEnabledStateResponse struct {
+			Return struct {
+				Item []struct {
+					Item []common.EnabledState `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_enabled_stateResponse"`
+	} `xml:"Body"`
+}
+
+// GetEnabledState
+// Introduced : BIG-IP_v11.0.0
+// Gets the enabled states of the specified members of the specified pools.
+func (p *PoolMemberV2) GetEnabledState(poolNames []string, members [][]global_lb.VirtualServerID) ([][]common.EnabledState, error) {
+	return p.GetEnabledStateCtx(context.Background(), poolNames, members)
+}
+
+// GetEnabledStateCtx is the context-aware variant of GetEnabledState.
+func (p *PoolMemberV2) GetEnabledStateCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]common.EnabledState, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetEnabledStateBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetEnabledStateBody{GetEnabledState{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp EnabledStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]common.EnabledState
+	for _, v := range resp.Body.GetEnabledStateResponse.Return.Item {
+		res = append(res, v.Item)
+	}
+
+	return res, nil
+}
+
+type setEnabledStateReq struct {
+	soap.BaseEnvEnvelope
+	Body setEnabledStateBody `xml:"env:Body"`
+}
+
+type setEnabledStateBody struct {
+	SetEnabledState setEnabledState `xml:"tns:set_member_enabled_state"`
+}
+
+type setEnabledState struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+	States    common.Sequences[common.EnabledState]       `xml:"states"`
+}
+
+// SetEnabledState
+// Introduced : BIG-IP_v11.0.0
+// Sets the enabled states of the specified members of the specified pools. A disabled member receives no new
+// requests, the connections it already serves are kept.
+func (p *PoolMemberV2) SetEnabledState(poolNames []string, members [][]global_lb.VirtualServerID, states [][]common.EnabledState) error {
+	return p.SetEnabledStateCtx(context.Background(), poolNames, members, states)
+}
+
+// SetEnabledStateCtx is the context-aware variant of SetEnabledState.
+func (p *PoolMemberV2) SetEnabledStateCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, states [][]common.EnabledState) error {
+
+	_, err := p.c.Call(ctx, setEnabledStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setEnabledStateBody{SetEnabledState: setEnabledState{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   common.NewSequences(members),
+			States:    common.NewSequences(states),
+		}},
+	})
+
+	return err
+}
+
+type GetRatioBody struct {
+	GetRatio GetRatio `xml:"tns:get_member_ratio"`
+}
+
+type GetRatio struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type RatioResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetRatioResponse struct {
+			Return struct {
+				Item []struct {
+					Item []int64 `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_ratioResponse"`
+	} `xml:"Body"`
+}
+
+// GetRatio
+// Introduced : BIG-IP_v11.0.0
+// Gets the ratios of the specified members of the specified pools.
+func (p *PoolMemberV2) GetRatio(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
+	return p.GetRatioCtx(context.Background(), poolNames, members)
+}
+
+// GetRatioCtx is the context-aware variant of GetRatio.
+func (p *PoolMemberV2) GetRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetRatioBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetRatioBody{GetRatio{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RatioResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]int64
+	for _, v := range resp.Body.GetRatioResponse.Return.Item {
+		res = append(res, v.Item)
+	}
+
+	return res, nil
+}
+
+type setRatioReq struct {
+	soap.BaseEnvEnvelope
+	Body setRatioBody `xml:"env:Body"`
+}
+
+type setRatioBody struct {
+	SetRatio setRatio `xml:"tns:set_member_ratio"`
+}
+
+type setRatio struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+	Ratios    common.Sequences[int64]                     `xml:"ratios"`
+}
+
+// SetRatio
+// Introduced : BIG-IP_v11.0.0
+// Sets the ratios of the specified members of the specified pools.
+func (p *PoolMemberV2) SetRatio(poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error {
+	return p.SetRatioCtx(context.Background(), poolNames, members, ratios)
+}
+
+// SetRatioCtx is the context-aware variant of SetRatio.
+func (p *PoolMemberV2) SetRatioCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, ratios [][]int64) error {
+
+	_, err := p.c.Call(ctx, setRatioReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setRatioBody{SetRatio: setRatio{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   common.NewSequences(members),
+			Ratios:    common.NewSequences(ratios),
+		}},
+	})
+
+	return err
+}
+
+type GetOrderBody struct {
+	GetOrder GetOrder `xml:"tns:get_member_order"`
+}
+
+type GetOrder struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type OrderResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetOrderResponse struct {
+			Return struct {
+				Item []struct {
+					Item []int64 `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_orderResponse"`
+	} `xml:"Body"`
+}
+
+// GetOrder
+// Introduced : BIG-IP_v11.0.0
+// Gets the orders of the specified members of the specified pools.
+func (p *PoolMemberV2) GetOrder(poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
+	return p.GetOrderCtx(context.Background(), poolNames, members)
+}
+
+// GetOrderCtx is the context-aware variant of GetOrder.
+func (p *PoolMemberV2) GetOrderCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]int64, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetOrderBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetOrderBody{GetOrder{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OrderResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]int64
+	for _, v := range resp.Body.GetOrderResponse.Return.Item {
+		res = append(res, v.Item)
+	}
+
+	return res, nil
+}
+
+type setOrderReq struct {
+	soap.BaseEnvEnvelope
+	Body setOrderBody `xml:"env:Body"`
+}
+
+type setOrderBody struct {
+	SetOrder setOrder `xml:"tns:set_member_order"`
+}
+
+type setOrder struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+	Orders    common.Sequences[int64]                     `xml:"orders"`
+}
+
+// SetOrder
+// Introduced : BIG-IP_v11.0.0
+// Sets the orders of the specified members of the specified pools.
+func (p *PoolMemberV2) SetOrder(poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+	return p.SetOrderCtx(context.Background(), poolNames, members, orders)
+}
+
+// SetOrderCtx is the context-aware variant of SetOrder.
+func (p *PoolMemberV2) SetOrderCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, orders [][]int64) error {
+
+	_, err := p.c.Call(ctx, setOrderReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setOrderBody{SetOrder: setOrder{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   common.NewSequences(members),
+			Orders:    common.NewSequences(orders),
+		}},
+	})
+
+	return err
+}
+
+type GetLimitBody struct {
+	GetLimit GetLimit `xml:"tns:get_member_limit"`
+}
+
+type GetLimit struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type LimitResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetLimitResponse struct {
+			Return common.Sequences[common.Items[global_lb.MetricLimit]] `xml:"return"`
+		} `xml:"get_member_limitResponse"`
+	} `xml:"Body"`
+}
+
+// GetLimit
+// Introduced : BIG-IP_v11.0.0
+// Gets the limits of the specified members of the specified pools.
+func (p *PoolMemberV2) GetLimit(poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.Limit, error) {
+	return p.GetLimitCtx(context.Background(), poolNames, members)
+}
+
+// GetLimitCtx is the context-aware variant of GetLimit.
+func (p *PoolMemberV2) GetLimitCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.Limit, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetLimitBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetLimitBody{GetLimit{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp LimitResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]global_lb.Limit
+	for _, v := range resp.Body.GetLimitResponse.Return.Item {
+		var item []global_lb.Limit
+		for _, v2 := range v.Item {
+			item = append(item, global_lb.NewLimit(v2.Item))
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+type setLimitReq struct {
+	soap.BaseEnvEnvelope
+	Body setLimitBody `xml:"env:Body"`
+}
+
+type setLimitBody struct {
+	SetLimit setLimit `xml:"tns:set_member_limit"`
+}
+
+type setLimit struct {
+	PoolNames PoolNames                                             `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID]           `xml:"members"`
+	Limits    common.Sequences[common.Items[global_lb.MetricLimit]] `xml:"limits"`
+}
+
+// SetLimit
+// Introduced : BIG-IP_v11.0.0
+// Sets the limits of the specified members of the specified pools, replacing their current limits.
+func (p *PoolMemberV2) SetLimit(poolNames []string, members [][]global_lb.VirtualServerID, limits [][]global_lb.Limit) error {
+	return p.SetLimitCtx(context.Background(), poolNames, members, limits)
+}
+
+// SetLimitCtx is the context-aware variant of SetLimit.
+func (p *PoolMemberV2) SetLimitCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, limits [][]global_lb.Limit) error {
+
+	_, err := p.c.Call(ctx, setLimitReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setLimitBody{SetLimit: setLimit{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   common.NewSequences(members),
+			Limits:    newLimitSequences(limits),
+		}},
+	})
+
+	return err
+}
+
+type GetMonitorRuleBody struct {
+	GetMonitorRule GetMonitorRule `xml:"tns:get_member_monitor_rule"`
+}
+
+type GetMonitorRule struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type MonitorRuleResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetMonitorRuleResponse struct {
+			Return struct {
+				Item []struct {
+					Item []global_lb.MonitorRule `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_monitor_ruleResponse"`
+	} `xml:"Body"`
+}
+
+// GetMonitorRule
+// Introduced : BIG-IP_v11.0.0
+// Gets the monitor rules of the specified members of the specified pools.
+func (p *PoolMemberV2) GetMonitorRule(poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.MonitorRule, error) {
+	return p.GetMonitorRuleCtx(context.Background(), poolNames, members)
+}
+
+// GetMonitorRuleCtx is the context-aware variant of GetMonitorRule.
+func (p *PoolMemberV2) GetMonitorRuleCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]global_lb.MonitorRule, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetMonitorRuleBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetMonitorRuleBody{GetMonitorRule{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MonitorRuleResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]global_lb.MonitorRule
+	for _, v := range resp.Body.GetMonitorRuleResponse.Return.Item {
+		res = append(res, v.Item)
+	}
+
+	return res, nil
+}
+
+type setMonitorRuleReq struct {
+	soap.BaseEnvEnvelope
+	Body setMonitorRuleBody `xml:"env:Body"`
+}
+
+type setMonitorRuleBody struct {
+	SetMonitorRule setMonitorRule `xml:"tns:set_member_monitor_rule"`
+}
+
+type setMonitorRule struct {
+	PoolNames    PoolNames                                   `xml:"pool_names"`
+	Members      common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+	MonitorRules common.Sequences[global_lb.MonitorRule]     `xml:"monitor_rules"`
+}
+
+// SetMonitorRule
+// Introduced : BIG-IP_v11.0.0
+// Sets the monitor rules of the specified members of the specified pools.
+func (p *PoolMemberV2) SetMonitorRule(poolNames []string, members [][]global_lb.VirtualServerID, monitorRules [][]global_lb.MonitorRule) error {
+	return p.SetMonitorRuleCtx(context.Background(), poolNames, members, monitorRules)
+}
+
+// SetMonitorRuleCtx is the context-aware variant of SetMonitorRule.
+func (p *PoolMemberV2) SetMonitorRuleCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, monitorRules [][]global_lb.MonitorRule) error {
+
+	_, err := p.c.Call(ctx, setMonitorRuleReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setMonitorRuleBody{SetMonitorRule: setMonitorRule{
+			PoolNames:    PoolNames{Item: poolNames},
+			Members:      common.NewSequences(members),
+			MonitorRules: common.NewSequences(monitorRules),
+		}},
+	})
+
+	return err
+}
+
+type GetDependencyBody struct {
+	GetDependency GetDependency `xml:"tns:get_member_dependency"`
+}
+
+type GetDependency struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type DependencyResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetDependencyResponse struct {
+			Return common.Sequences[common.Items[global_lb.VirtualServerID]] `xml:"return"`
+		} `xml:"get_member_dependencyResponse"`
+	} `xml:"Body"`
+}
+
+// GetDependency
+// Introduced : BIG-IP_v11.0.0
+// Gets the virtual servers the specified members of the specified pools depend on.
+// A member is unavailable while one of its dependencies is.
+func (p *PoolMemberV2) GetDependency(poolNames []string, members [][]global_lb.VirtualServerID) ([][][]global_lb.VirtualServerID, error) {
+	return p.GetDependencyCtx(context.Background(), poolNames, members)
+}
+
+// GetDependencyCtx is the context-aware variant of GetDependency.
+func (p *PoolMemberV2) GetDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][][]global_lb.VirtualServerID, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetDependencyBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetDependencyBody{GetDependency{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DependencyResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][][]global_lb.VirtualServerID
+	for _, v := range resp.Body.GetDependencyResponse.Return.Item {
+		var item [][]global_lb.VirtualServerID
+		for _, v2 := range v.Item {
+			item = append(item, v2.Item)
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+type addDependencyReq struct {
+	soap.BaseEnvEnvelope
+	Body addDependencyBody `xml:"env:Body"`
+}
+
+type addDependencyBody struct {
+	AddDependency addDependency `xml:"tns:add_member_dependency"`
+}
+
+type addDependency struct {
+	PoolNames    PoolNames                                                 `xml:"pool_names"`
+	Members      common.Sequences[global_lb.VirtualServerID]               `xml:"members"`
+	Dependencies common.Sequences[common.Items[global_lb.VirtualServerID]] `xml:"dependencies"`
+}
+
+// AddDependency
+// Introduced : BIG-IP_v11.0.0
+// Adds virtual servers to the dependencies of the specified members of the specified pools.
+func (p *PoolMemberV2) AddDependency(poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error {
+	return p.AddDependencyCtx(context.Background(), poolNames, members, dependencies)
+}
+
+// AddDependencyCtx is the context-aware variant of AddDependency.
+func (p *PoolMemberV2) AddDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error {
+
+	_, err := p.c.Call(ctx, addDependencyReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: addDependencyBody{AddDependency: addDependency{
+			PoolNames:    PoolNames{Item: poolNames},
+			Members:      common.NewSequences(members),
+			Dependencies: newDependencySequences(dependencies),
+		}},
+	})
+
+	return err
+}
+
+type removeDependencyReq struct {
+	soap.BaseEnvEnvelope
+	Body removeDependencyBody `xml:"env:Body"`
+}
+
+type removeDependencyBody struct {
+	RemoveDependency removeDependency `xml:"tns:remove_member_dependency"`
+}
+
+type removeDependency struct {
+	PoolNames    PoolNames                                                 `xml:"pool_names"`
+	Members      common.Sequences[global_lb.VirtualServerID]               `xml:"members"`
+	Dependencies common.Sequences[common.Items[global_lb.VirtualServerID]] `xml:"dependencies"`
+}
+
+// RemoveDependency
+// Introduced : BIG-IP_v11.0.0
+// Removes virtual servers from the dependencies of the specified members of the specified pools.
+func (p *PoolMemberV2) RemoveDependency(poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error {
+	return p.RemoveDependencyCtx(context.Background(), poolNames, members, dependencies)
+}
+
+// RemoveDependencyCtx is the context-aware variant of RemoveDependency.
+func (p *PoolMemberV2) RemoveDependencyCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, dependencies [][][]global_lb.VirtualServerID) error {
+
+	_, err := p.c.Call(ctx, removeDependencyReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: removeDependencyBody{RemoveDependency: removeDependency{
+			PoolNames:    PoolNames{Item: poolNames},
+			Members:      common.NewSequences(members),
+			Dependencies: newDependencySequences(dependencies),
+		}},
+	})
+
+	return err
+}
+
+type removeAllDependenciesReq struct {
+	soap.BaseEnvEnvelope
+	Body removeAllDependenciesBody `xml:"env:Body"`
+}
+
+type removeAllDependenciesBody struct {
+	RemoveAllDependencies removeAllDependencies `xml:"tns:remove_all_member_dependencies"`
+}
+
+type removeAllDependencies struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+// RemoveAllDependencies
+// Introduced : BIG-IP_v11.0.0
+// Removes all the dependencies of the specified members of the specified pools.
+func (p *PoolMemberV2) RemoveAllDependencies(poolNames []string, members [][]global_lb.VirtualServerID) error {
+	return p.RemoveAllDependenciesCtx(context.Background(), poolNames, members)
+}
+
+// RemoveAllDependenciesCtx is the context-aware variant of RemoveAllDependencies.
+func (p *PoolMemberV2) RemoveAllDependenciesCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) error {
+
+	_, err := p.c.Call(ctx, removeAllDependenciesReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: removeAllDependenciesBody{RemoveAllDependencies: removeAllDependencies{
+			PoolNames: PoolNames{Item: poolNames},
+			Members:   common.NewSequences(members),
+		}},
+	})
+
+	return err
+}
+
+type GetDescriptionBody struct {
+	GetDescription GetDescription `xml:"tns:get_member_description"`
+}
+
+type GetDescription struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type DescriptionResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetDescriptionResponse struct {
+			Return struct {
+				Item []struct {
+					Item []string `xml:"item"`
+				} `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_descriptionResponse"`
+	} `xml:"Body"`
+}
+
+// GetDescription
+// Introduced : BIG-IP_v11.0.0
+// Gets the descriptions of the specified members of the specified pools.
+func (p *PoolMemberV2) GetDescription(poolNames []string, members [][]global_lb.VirtualServerID) ([][]string, error) {
+	return p.GetDescriptionCtx(context.Background(), poolNames, members)
+}
+
+// GetDescriptionCtx is the context-aware variant of GetDescription.
+func (p *PoolMemberV2) GetDescriptionCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([][]string, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetDescriptionBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetDescriptionBody{GetDescription{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DescriptionResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	var res [][]string
+	for _, v := range resp.Body.GetDescriptionResponse.Return.Item {
+		res = append(res, v.Item)
+	}
+
+	return res, nil
+}
+
+type setDescriptionReq struct {
+	soap.BaseEnvEnvelope
+	Body setDescriptionBody `xml:"env:Body"`
+}
+
+type setDescriptionBody struct {
+	SetDescription setDescription `xml:"tns:set_member_description"`
+}
+
+type setDescription struct {
+	PoolNames    PoolNames                                   `xml:"pool_names"`
+	Members      common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+	Descriptions common.Sequences[string]                    `xml:"descriptions"`
+}
+
+// SetDescription
+// Introduced : BIG-IP_v11.0.0
+// Sets the descriptions of the specified members of the specified pools.
+func (p *PoolMemberV2) SetDescription(poolNames []string, members [][]global_lb.VirtualServerID, descriptions [][]string) error {
+	return p.SetDescriptionCtx(context.Background(), poolNames, members, descriptions)
+}
+
+// SetDescriptionCtx is the context-aware variant of SetDescription.
+func (p *PoolMemberV2) SetDescriptionCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID, descriptions [][]string) error {
+
+	_, err := p.c.Call(ctx, setDescriptionReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setDescriptionBody{SetDescription: setDescription{
+			PoolNames:    PoolNames{Item: poolNames},
+			Members:      common.NewSequences(members),
+			Descriptions: common.NewSequences(descriptions),
+		}},
+	})
+
+	return err
+}
+
+type GetStatisticsBody struct {
+	GetStatistics GetStatistics `xml:"tns:get_member_statistics"`
+}
+
+type GetStatistics struct {
+	PoolNames PoolNames                                   `xml:"pool_names"`
+	Members   common.Sequences[global_lb.VirtualServerID] `xml:"members"`
+}
+
+type StatisticsResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetStatisticsResponse struct {
+			Return struct {
+				Item []MemberStatistics `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_member_statisticsResponse"`
+	} `xml:"Body"`
+}
+
+// GetStatistics
+// Introduced : BIG-IP_v11.0.0
+// Gets the statistics of the specified members of the specified pools, one entry per pool.
+func (p *PoolMemberV2) GetStatistics(poolNames []string, members [][]global_lb.VirtualServerID) ([]MemberStatistics, error) {
+	return p.GetStatisticsCtx(context.Background(), poolNames, members)
+}
+
+// GetStatisticsCtx is the context-aware variant of GetStatistics.
+func (p *PoolMemberV2) GetStatisticsCtx(ctx context.Context, poolNames []string, members [][]global_lb.VirtualServerID) ([]MemberStatistics, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetStatisticsBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetStatisticsBody{GetStatistics{PoolNames{Item: poolNames}, common.NewSequences(members)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp StatisticsResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetStatisticsResponse.Return.Item, nil
+}
+
+// newLimitSequences returns the limits of each member of each pool as their metric limits.
+func newLimitSequences(values [][]global_lb.Limit) common.Sequences[common.Items[global_lb.MetricLimit]] {
+	res := make([][]common.Items[global_lb.MetricLimit], len(values))
+	for i, v := range values {
+		for _, l := range v {
+			res[i] = append(res[i], common.Items[global_lb.MetricLimit]{Item: l.MetricLimits()})
+		}
+	}
+	return common.NewSequences(res)
+}
+
+// newDependencySequences returns the dependencies of each member of each pool as an array of arrays of arrays.
+func newDependencySequences(values [][][]global_lb.VirtualServerID) common.Sequences[common.Items[global_lb.VirtualServerID]] {
+	res := make([][]common.Items[global_lb.VirtualServerID], len(values))
+	for i, v := range values {
+		for _, d := range v {
+			res[i] = append(res[i], common.Items[global_lb.VirtualServerID]{Item: d})
+		}
+	}
+	return common.NewSequences(res)
+}
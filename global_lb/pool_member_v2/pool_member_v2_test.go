@@ -0,0 +1,347 @@
+package pool_member_v2
+
+import (
+	"reflect"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb"
+)
+
+var (
+	pool1 = []string{"/Common/pool1"}
+	vs1   = global_lb.VirtualServerID{Name: "vs1", Server: "/Common/bigip1"}
+	vs2   = global_lb.VirtualServerID{Name: "vs2", Server: "/Common/bigip2"}
+	vs3   = global_lb.VirtualServerID{Name: "vs3", Server: "/Common/bigip1"}
+)
+
+func newClient(t *testing.T) *soap.Client {
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{Name: "vs1", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.0.0.1", Port: 80}},
+		f5test.VirtualServer{Name: "vs2", Server: "/Common/bigip2", Destination: common.IPPortDefinition{Address: "10.0.0.2", Port: 80}},
+		f5test.VirtualServer{Name: "vs3", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.0.0.3", Port: 443}},
+	)
+	s.AddPools(f5test.Pool{
+		Name: "/Common/pool1",
+		Members: []f5test.PoolMember{
+			{
+				Name:        "vs1",
+				Server:      "/Common/bigip1",
+				Ratio:       2,
+				Order:       1,
+				Limits:      []global_lb.MetricLimit{{MetricType: global_lb.MetricLimitCurrentConnections, Value: 500}},
+				Monitor:     global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/http"}},
+				Description: "web frontend",
+				Statistics:  map[common.StatisticType]uint64{common.StatisticServerSideCurrentConnections: 5, common.StatisticServerSideTotalConnections: 1 << 33},
+			},
+			{
+				Name:         "vs2",
+				Server:       "/Common/bigip2",
+				Enabled:      common.StateDisabled,
+				Dependencies: []global_lb.VirtualServerID{{Name: "vs3", Server: "/Common/bigip1"}},
+			},
+		},
+	})
+
+	return s.Client()
+}
+
+func TestPoolMemberV2_EnabledState(t *testing.T) {
+	p := New(newClient(t))
+
+	arr, err := p.GetEnabledState(pool1, [][]global_lb.VirtualServerID{{vs1, vs2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]common.EnabledState{{common.StateEnabled, common.StateDisabled}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetEnabledState(pool1, [][]global_lb.VirtualServerID{{vs1, vs2}}, [][]common.EnabledState{{common.StateDisabled, common.StateEnabled}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetEnabledState(pool1, [][]global_lb.VirtualServerID{{vs1, vs2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]common.EnabledState{{common.StateDisabled, common.StateEnabled}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetEnabledState(pool1, [][]global_lb.VirtualServerID{{vs1}}, [][]common.EnabledState{{"STATE_AAAA"}}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+	if _, err := p.GetEnabledState(pool1, [][]global_lb.VirtualServerID{{vs3}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if _, err := p.GetEnabledState([]string{"/Common/aaaa"}, [][]global_lb.VirtualServerID{{vs1}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolMemberV2_RatioAndOrder(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1, vs2}}
+	ratios, err := p.GetRatio(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int64{{2, 1}}; !reflect.DeepEqual(ratios, want) {
+		t.Fatalf("expected %v, got %v", want, ratios)
+	}
+	orders, err := p.GetOrder(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int64{{1, 0}}; !reflect.DeepEqual(orders, want) {
+		t.Fatalf("expected %v, got %v", want, orders)
+	}
+
+	if err := p.SetRatio(pool1, members, [][]int64{{5, 6}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetOrder(pool1, members, [][]int64{{0, 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	ratios, err = p.GetRatio(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int64{{5, 6}}; !reflect.DeepEqual(ratios, want) {
+		t.Fatalf("expected %v, got %v", want, ratios)
+	}
+	orders, err = p.GetOrder(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int64{{0, 1}}; !reflect.DeepEqual(orders, want) {
+		t.Fatalf("expected %v, got %v", want, orders)
+	}
+
+	if err := p.SetOrder(pool1, members, [][]int64{{1}}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPoolMemberV2_Limit(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1, vs2}}
+	arr, err := p.GetLimit(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]global_lb.Limit{{{CurrentConnections: 500}, {}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	want := [][]global_lb.Limit{{{}, {BitsPerSecond: 1000000, PacketsPerSecond: 2000}}}
+	if err := p.SetLimit(pool1, members, want); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetLimit(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+}
+
+func TestPoolMemberV2_ClearLimit(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1}}
+	if err := p.SetLimit(pool1, members, [][]global_lb.Limit{{{CurrentConnections: 100, KilobitsPerSecond: 2000}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetLimit(pool1, members, [][]global_lb.Limit{{{KilobitsPerSecond: 2000}}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err := p.GetLimit(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]global_lb.Limit{{{KilobitsPerSecond: 2000}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.SetLimit(pool1, members, [][]global_lb.Limit{{{}}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetLimit(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]global_lb.Limit{{{}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+}
+
+func TestPoolMemberV2_MonitorRule(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1, vs2}}
+	arr, err := p.GetMonitorRule(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]global_lb.MonitorRule{{
+		{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/http"}},
+		{Type: global_lb.MonitorRuleTypeNone},
+	}}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	rule := global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeMOfN, Quorum: 1, MonitorTemplates: []string{"/Common/http", "/Common/tcp"}}
+	if err := p.SetMonitorRule(pool1, [][]global_lb.VirtualServerID{{vs2}}, [][]global_lb.MonitorRule{{rule}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetMonitorRule(pool1, [][]global_lb.VirtualServerID{{vs2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]global_lb.MonitorRule{{rule}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	missing := global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/aaaa"}}
+	if err := p.SetMonitorRule(pool1, [][]global_lb.VirtualServerID{{vs2}}, [][]global_lb.MonitorRule{{missing}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolMemberV2_Dependency(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1, vs2}}
+	arr, err := p.GetDependency(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][][]global_lb.VirtualServerID{{nil, {vs3}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.AddDependency(pool1, members, [][][]global_lb.VirtualServerID{{{vs2, vs3}, {vs1}}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetDependency(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][][]global_lb.VirtualServerID{{{vs2, vs3}, {vs3, vs1}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.RemoveDependency(pool1, [][]global_lb.VirtualServerID{{vs2}}, [][][]global_lb.VirtualServerID{{{vs3}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.RemoveAllDependencies(pool1, [][]global_lb.VirtualServerID{{vs1}}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetDependency(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][][]global_lb.VirtualServerID{{nil, {vs1}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.AddDependency(pool1, [][]global_lb.VirtualServerID{{vs1}}, [][][]global_lb.VirtualServerID{{{vs1}}}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+	vs9 := global_lb.VirtualServerID{Name: "vs9", Server: "/Common/bigip1"}
+	if err := p.AddDependency(pool1, [][]global_lb.VirtualServerID{{vs1}}, [][][]global_lb.VirtualServerID{{{vs9}}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if err := p.RemoveDependency(pool1, [][]global_lb.VirtualServerID{{vs1}}, [][][]global_lb.VirtualServerID{{{vs3}}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolMemberV2_Description(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs1, vs2}}
+	if err := p.SetDescription(pool1, [][]global_lb.VirtualServerID{{vs2}}, [][]string{{"api <v2>"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetDescription(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"web frontend", "api <v2>"}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+}
+
+func TestPoolMemberV2_GetStatistics(t *testing.T) {
+	p := New(newClient(t))
+
+	arr, err := p.GetStatistics(pool1, [][]global_lb.VirtualServerID{{vs1, vs2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 1 || len(arr[0].Statistics) != 2 {
+		t.Fatalf("unexpected statistics %+v", arr)
+	}
+	if arr[0].TimeStamp.Year == 0 {
+		t.Fatalf("expected a time stamp, got %+v", arr[0].TimeStamp)
+	}
+
+	tests := []struct {
+		entry MemberStatisticEntry
+		typ   common.StatisticType
+		want  uint64
+	}{
+		{arr[0].Statistics[0], common.StatisticServerSideCurrentConnections, 5},
+		{arr[0].Statistics[0], common.StatisticServerSideTotalConnections, 1 << 33},
+		{arr[0].Statistics[1], common.StatisticServerSideCurrentConnections, 0},
+	}
+	for _, tt := range tests {
+		v, ok := tt.entry.Statistics.Value(tt.typ)
+		if !ok || v != tt.want {
+			t.Errorf("%s of %s: expected %d, got %d (%v)", tt.typ, tt.entry.Member.Name, tt.want, v, ok)
+		}
+	}
+	if arr[0].Statistics[1].Member != vs2 {
+		t.Fatalf("expected %+v, got %+v", vs2, arr[0].Statistics[1].Member)
+	}
+}
+
+func TestPoolMemberV2_Drain(t *testing.T) {
+	p := New(newClient(t))
+
+	members := [][]global_lb.VirtualServerID{{vs2}}
+	if err := p.SetEnabledState(pool1, members, [][]common.EnabledState{{common.StateDisabled}}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetStatistics(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := arr[0].Statistics[0].Statistics.Value(common.StatisticServerSideCurrentConnections); v != 0 {
+		t.Fatalf("expected the member to be drained, got %d connections", v)
+	}
+
+	if err := p.SetEnabledState(pool1, members, [][]common.EnabledState{{common.StateEnabled}}); err != nil {
+		t.Fatal(err)
+	}
+	states, err := p.GetEnabledState(pool1, members)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]common.EnabledState{{common.StateEnabled}}; !reflect.DeepEqual(states, want) {
+		t.Fatalf("expected %v, got %v", want, states)
+	}
+}
@@ -3,6 +3,8 @@ package pool_v2
 import (
 	"context"
 	"encoding/xml"
+	"errors"
+	"fmt"
 
 	soap "github.com/wule61/go-f5-soap"
 	"github.com/wule61/go-f5-soap/common"
@@ -26,12 +28,58 @@ const tns = "urn:iControl:GlobalLB/PoolV2"
 // the name of a corresponding wide IP (without the folder name),
 // except in the case mentioned above where the non-terminal is a CNAME type member with static-target enabled.
 type IPoolV2 interface {
-	GetMember(pools []PoolID) ([][]Member, error)
-	GetList() ([]PoolID, error)
-	GetListByType(gtmQueryType []global_lb.GTMQueryType) ([][]PoolID, error)
-	GetTTL(pools []PoolID) ([]int64, error)
-	GetEnabledState(pools []PoolID) ([]common.EnabledState, error)
-	GetObjectStatus(pools []PoolID) ([]common.ObjectStatus, error)
+	GetMember(pools []global_lb.PoolID) ([][]Member, error)
+	GetMemberCtx(ctx context.Context, pools []global_lb.PoolID) ([][]Member, error)
+	AddMember(pools []global_lb.PoolID, members [][]Member) error
+	AddMemberCtx(ctx context.Context, pools []global_lb.PoolID, members [][]Member) error
+	RemoveMember(pools []global_lb.PoolID, members [][]MemberID) error
+	RemoveMemberCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID) error
+	SetMemberOrder(pools []global_lb.PoolID, members [][]MemberID, orders [][]int64) error
+	SetMemberOrderCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID, orders [][]int64) error
+	SetMemberRatio(pools []global_lb.PoolID, members [][]MemberID, ratios [][]int64) error
+	SetMemberRatioCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID, ratios [][]int64) error
+	GetList() ([]global_lb.PoolID, error)
+	GetListCtx(ctx context.Context) ([]global_lb.PoolID, error)
+	GetListByType(gtmQueryType []global_lb.GTMQueryType) ([][]global_lb.PoolID, error)
+	GetListByTypeCtx(ctx context.Context, gtmQueryType []global_lb.GTMQueryType) ([][]global_lb.PoolID, error)
+	GetTTL(pools []global_lb.PoolID) ([]int64, error)
+	GetTTLCtx(ctx context.Context, pools []global_lb.PoolID) ([]int64, error)
+	GetEnabledState(pools []global_lb.PoolID) ([]common.EnabledState, error)
+	GetEnabledStateCtx(ctx context.Context, pools []global_lb.PoolID) ([]common.EnabledState, error)
+	GetObjectStatus(pools []global_lb.PoolID) ([]common.ObjectStatus, error)
+	GetObjectStatusCtx(ctx context.Context, pools []global_lb.PoolID) ([]common.ObjectStatus, error)
+	GetPreferredLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetPreferredLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetAlternateLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetAlternateLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetFallbackLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetFallbackLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error)
+	GetMonitorAssociation(pools []global_lb.PoolID) ([]MonitorAssociation, error)
+	GetMonitorAssociationCtx(ctx context.Context, pools []global_lb.PoolID) ([]MonitorAssociation, error)
+	Create(pools []global_lb.PoolID, lbMethods []global_lb.LBMethod, members [][]Member) error
+	CreateCtx(ctx context.Context, pools []global_lb.PoolID, lbMethods []global_lb.LBMethod, members [][]Member) error
+	DeletePool(pools []global_lb.PoolID) error
+	DeletePoolCtx(ctx context.Context, pools []global_lb.PoolID) error
+	DeleteAllPools() error
+	DeleteAllPoolsCtx(ctx context.Context) error
+	SetPreferredLBMethod(pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetPreferredLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetAlternateLBMethod(pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetAlternateLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetFallbackLBMethod(pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetFallbackLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, lbMethods []global_lb.LBMethod) error
+	SetTTL(pools []global_lb.PoolID, values []int64) error
+	SetTTLCtx(ctx context.Context, pools []global_lb.PoolID, values []int64) error
+	SetAnswersToReturn(pools []global_lb.PoolID, answers []int64) error
+	SetAnswersToReturnCtx(ctx context.Context, pools []global_lb.PoolID, answers []int64) error
+	SetFallbackIP(pools []global_lb.PoolID, ips []string) error
+	SetFallbackIPCtx(ctx context.Context, pools []global_lb.PoolID, ips []string) error
+	SetEnabledState(pools []global_lb.PoolID, states []common.EnabledState) error
+	SetEnabledStateCtx(ctx context.Context, pools []global_lb.PoolID, states []common.EnabledState) error
+	SetMonitorAssociation(monitorAssociations []MonitorAssociation) error
+	SetMonitorAssociationCtx(ctx context.Context, monitorAssociations []MonitorAssociation) error
+	RemoveMonitorAssociation(pools []global_lb.PoolID) error
+	RemoveMonitorAssociationCtx(ctx context.Context, pools []global_lb.PoolID) error
 }
 
 var _ IPoolV2 = (*PoolV2)(nil)
@@ -46,9 +94,118 @@ func New(c *soap.Client) *PoolV2 {
 	}
 }
 
-type PoolID struct {
-	PoolName string `xml:"pool_name"`
-	PoolType string `xml:"pool_type"`
+// PoolID identifies a typed pool.
+//
+// Deprecated: use global_lb.PoolID, which it is an alias of.
+type PoolID = global_lb.PoolID
+
+// ErrInvalidMember is returned when a member does not fit the type of its pool.
+var ErrInvalidMember = errors.New("invalid pool member")
+
+// MemberID
+// Introduced : BIG-IP_v12.0.0
+// Identifies a member of a typed pool: a virtual server, by name and server, for A and AAAA pools,
+// or a non-terminal member, by its dname alone, for CNAME, MX, SRV and NAPTR pools.
+type MemberID struct {
+	Name   string `xml:"name" json:"name" yaml:"name"`
+	Server string `xml:"server" json:"server,omitempty" yaml:"server,omitempty"`
+}
+
+// IsNonTerminal reports whether id is a non-terminal member.
+func (id MemberID) IsNonTerminal() bool {
+	return id.Server == ""
+}
+
+// Member
+// Introduced : BIG-IP_v12.0.0
+// A member of a typed pool with its order, ratio and the settings of its pool type.
+// The non-terminal settings are left empty for the pool types they do not apply to.
+type Member struct {
+	// Name is the virtual server name for A and AAAA pools, the dname of the non-terminal member otherwise.
+	Name string `xml:"name" json:"name" yaml:"name"`
+	// Server is the server of the virtual server, empty for non-terminal members.
+	Server string `xml:"server" json:"server,omitempty" yaml:"server,omitempty"`
+	Order  int64  `xml:"order" json:"order" yaml:"order"`
+	Ratio  int64  `xml:"ratio" json:"ratio" yaml:"ratio"`
+
+	// StaticTarget is set for CNAME members. When enabled the dname needs no wide IP.
+	StaticTarget common.EnabledState `xml:"static_target,omitempty" json:"static_target,omitempty" yaml:"static_target,omitempty"`
+	// Flags, Service, Regexp and Replacement are set for NAPTR members.
+	Flags       string `xml:"flags,omitempty" json:"flags,omitempty" yaml:"flags,omitempty"`
+	Service     string `xml:"service,omitempty" json:"service,omitempty" yaml:"service,omitempty"`
+	Regexp      string `xml:"regexp,omitempty" json:"regexp,omitempty" yaml:"regexp,omitempty"`
+	Replacement string `xml:"replacement,omitempty" json:"replacement,omitempty" yaml:"replacement,omitempty"`
+	// Priority is set for MX and SRV members, Weight and Port for SRV members.
+	Priority int64 `xml:"priority,omitempty" json:"priority,omitempty" yaml:"priority,omitempty"`
+	Weight   int64 `xml:"weight,omitempty" json:"weight,omitempty" yaml:"weight,omitempty"`
+	Port     int64 `xml:"port,omitempty" json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+// VirtualServerMember returns the member of an A or AAAA pool for the virtual server vs.
+func VirtualServerMember(vs global_lb.VirtualServerID) Member {
+	return Member{Name: vs.Name, Server: vs.Server, Ratio: 1}
+}
+
+// CNAMEMember returns the member of a CNAME pool for dname.
+func CNAMEMember(dname string, staticTarget bool) Member {
+	m := Member{Name: dname, Ratio: 1, StaticTarget: common.StateDisabled}
+	if staticTarget {
+		m.StaticTarget = common.StateEnabled
+	}
+	return m
+}
+
+// MXMember returns the member of an MX pool for dname.
+func MXMember(dname string, priority int64) Member {
+	return Member{Name: dname, Ratio: 1, Priority: priority}
+}
+
+// SRVMember returns the member of an SRV pool for dname.
+func SRVMember(dname string, priority, weight, port int64) Member {
+	return Member{Name: dname, Ratio: 1, Priority: priority, Weight: weight, Port: port}
+}
+
+// NAPTRMember returns the member of a NAPTR pool for dname.
+func NAPTRMember(dname, flags, service, regexp, replacement string) Member {
+	return Member{Name: dname, Ratio: 1, Flags: flags, Service: service, Regexp: regexp, Replacement: replacement}
+}
+
+// ID returns the identifier of m.
+func (m Member) ID() MemberID {
+	return MemberID{Name: m.Name, Server: m.Server}
+}
+
+// Validate checks that m fits a pool of type t: a virtual server for A and AAAA pools,
+// a non-terminal member holding only the settings of t otherwise.
+func (m Member) Validate(t global_lb.GTMQueryType) error {
+	if m.Name == "" {
+		return fmt.Errorf("%w: missing name", ErrInvalidMember)
+	}
+
+	var allowed Member
+	switch t {
+	case global_lb.GtmQueryTypeA, global_lb.GtmQueryTypeAAAA:
+		if m.Server == "" {
+			return fmt.Errorf("%w %q: missing server for a %s pool", ErrInvalidMember, m.Name, t)
+		}
+		allowed.Server = m.Server
+	case global_lb.GtmQueryTypeCname:
+		allowed.StaticTarget = m.StaticTarget
+	case global_lb.GtmQueryTypeMX:
+		allowed.Priority = m.Priority
+	case global_lb.GtmQueryTypeSRV:
+		allowed.Priority, allowed.Weight, allowed.Port = m.Priority, m.Weight, m.Port
+	case global_lb.GtmQueryTypeNAPTR:
+		allowed.Flags, allowed.Service, allowed.Regexp, allowed.Replacement = m.Flags, m.Service, m.Regexp, m.Replacement
+	default:
+		return fmt.Errorf("%w %q: unsupported pool type %s", ErrInvalidMember, m.Name, t)
+	}
+
+	allowed.Name, allowed.Order, allowed.Ratio = m.Name, m.Order, m.Ratio
+	if m != allowed {
+		return fmt.Errorf("%w %q: settings not applying to a %s pool", ErrInvalidMember, m.Name, t)
+	}
+	return nil
 }
 
 type GetMemberBody struct {
@@ -60,7 +217,7 @@ type GetMember struct {
 }
 
 type Pools struct {
-	Item []PoolID `xml:"item"`
+	Item []global_lb.PoolID `xml:"item"`
 }
 
 type MemberResp struct {
@@ -69,33 +226,29 @@ type MemberResp struct {
 		GetMemberResponse struct {
 			Return struct {
 				Item []struct {
-					Item []struct {
-						Name struct {
-							Text string `xml:",chardata"`
-						} `xml:"name"`
-						Server struct {
-							Text string `xml:",chardata"`
-						} `xml:"server"`
-					} `xml:"item"`
+					Item []Member `xml:"item"`
 				} `xml:"item"`
 			} `xml:"return"`
 		} `xml:"get_memberResponse"`
 	} `xml:"Body"`
 }
 
-type Member struct {
-	Name   string
-	Server string
+// GetMember
+// Introduced : BIG-IP_v12.0.0
+// Gets the members of the specified pools, with the settings of their pool type.
+func (p *PoolV2) GetMember(pools []global_lb.PoolID) ([][]Member, error) {
+	return p.GetMemberCtx(context.Background(), pools)
 }
 
-func (p *PoolV2) GetMember(pools []PoolID) ([][]Member, error) {
+// GetMemberCtx is the context-aware variant of GetMember.
+func (p *PoolV2) GetMemberCtx(ctx context.Context, pools []global_lb.PoolID) ([][]Member, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetMemberBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetMemberBody{GetMember: GetMember{Pools: Pools{
 			Item: pools,
@@ -112,14 +265,7 @@ func (p *PoolV2) GetMember(pools []PoolID) ([][]Member, error) {
 
 	var res [][]Member
 	for _, v := range resp.Body.GetMemberResponse.Return.Item {
-		var poolMembers []Member
-		for _, v2 := range v.Item {
-			poolMembers = append(poolMembers, Member{
-				Name:   v2.Name.Text,
-				Server: v2.Server.Text,
-			})
-		}
-		res = append(res, poolMembers)
+		res = append(res, v.Item)
 	}
 
 	return res, nil
@@ -148,7 +294,7 @@ type ListByTypeResp struct {
 							Text string `xml:",chardata" `
 						} `xml:"pool_name" `
 						PoolType struct {
-							Text string `xml:",chardata" `
+							Text global_lb.GTMQueryType `xml:",chardata" `
 						} `xml:"pool_type" `
 					} `xml:"item" `
 				} `xml:"item" `
@@ -173,7 +319,7 @@ type ListResp struct {
 						Text string `xml:",chardata" `
 					} `xml:"pool_name" `
 					PoolType struct {
-						Text string `xml:",chardata" `
+						Text global_lb.GTMQueryType `xml:",chardata" `
 					} `xml:"pool_type" `
 				} `xml:"item" `
 			} `xml:"return"`
@@ -181,14 +327,19 @@ type ListResp struct {
 	} `xml:"Body" `
 }
 
-func (p *PoolV2) GetList() ([]PoolID, error) {
+func (p *PoolV2) GetList() ([]global_lb.PoolID, error) {
+	return p.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (p *PoolV2) GetListCtx(ctx context.Context) ([]global_lb.PoolID, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetListBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetListBody{GetList: GetList{}},
 	})
@@ -201,9 +352,9 @@ func (p *PoolV2) GetList() ([]PoolID, error) {
 		return nil, err
 	}
 
-	var res []PoolID
+	var res []global_lb.PoolID
 	for _, v := range resp.Body.GetListResponse.Return.Item {
-		res = append(res, PoolID{
+		res = append(res, global_lb.PoolID{
 			PoolName: v.PoolName.Text,
 			PoolType: v.PoolType.Text,
 		})
@@ -213,14 +364,19 @@ func (p *PoolV2) GetList() ([]PoolID, error) {
 
 }
 
-func (p *PoolV2) GetListByType(gtmQueryType []global_lb.GTMQueryType) ([][]PoolID, error) {
+func (p *PoolV2) GetListByType(gtmQueryType []global_lb.GTMQueryType) ([][]global_lb.PoolID, error) {
+	return p.GetListByTypeCtx(context.Background(), gtmQueryType)
+}
+
+// GetListByTypeCtx is the context-aware variant of GetListByType.
+func (p *PoolV2) GetListByTypeCtx(ctx context.Context, gtmQueryType []global_lb.GTMQueryType) ([][]global_lb.PoolID, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetListByTypeBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetListByTypeBody{GetListByType: GetListByType{
 			Types: Types{
@@ -237,11 +393,11 @@ func (p *PoolV2) GetListByType(gtmQueryType []global_lb.GTMQueryType) ([][]PoolI
 		return nil, err
 	}
 
-	var res [][]PoolID
+	var res [][]global_lb.PoolID
 	for _, v := range resp.Body.GetListByTypeResponse.Return.Item {
-		var poolIDArr []PoolID
+		var poolIDArr []global_lb.PoolID
 		for _, v2 := range v.Item {
-			poolIDArr = append(poolIDArr, PoolID{
+			poolIDArr = append(poolIDArr, global_lb.PoolID{
 				PoolName: v2.PoolName.Text,
 				PoolType: v2.PoolType.Text,
 			})
@@ -271,14 +427,19 @@ type TTLResp struct {
 	} `xml:"Body"`
 }
 
-func (p *PoolV2) GetTTL(pools []PoolID) ([]int64, error) {
+func (p *PoolV2) GetTTL(pools []global_lb.PoolID) ([]int64, error) {
+	return p.GetTTLCtx(context.Background(), pools)
+}
+
+// GetTTLCtx is the context-aware variant of GetTTL.
+func (p *PoolV2) GetTTLCtx(ctx context.Context, pools []global_lb.PoolID) ([]int64, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetTTLBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetTTLBody{GetTTL: GetTTL{
 			Pools: Pools{Item: pools},
@@ -315,14 +476,19 @@ type EnableStateResp struct {
 	} `xml:"Body"`
 }
 
-func (p *PoolV2) GetEnabledState(pools []PoolID) ([]common.EnabledState, error) {
+func (p *PoolV2) GetEnabledState(pools []global_lb.PoolID) ([]common.EnabledState, error) {
+	return p.GetEnabledStateCtx(context.Background(), pools)
+}
+
+// GetEnabledStateCtx is the context-aware variant of GetEnabledState.
+func (p *PoolV2) GetEnabledStateCtx(ctx context.Context, pools []global_lb.PoolID) ([]common.EnabledState, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetEnabledStateBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetEnabledStateBody{GetEnabledState: GetEnabledState{Pools: Pools{
 			Item: pools,
@@ -371,14 +537,19 @@ type ObjectStatusResp struct {
 	} `xml:"Body"`
 }
 
-func (p *PoolV2) GetObjectStatus(pools []PoolID) ([]common.ObjectStatus, error) {
+func (p *PoolV2) GetObjectStatus(pools []global_lb.PoolID) ([]common.ObjectStatus, error) {
+	return p.GetObjectStatusCtx(context.Background(), pools)
+}
+
+// GetObjectStatusCtx is the context-aware variant of GetObjectStatus.
+func (p *PoolV2) GetObjectStatusCtx(ctx context.Context, pools []global_lb.PoolID) ([]common.ObjectStatus, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetObjectStatusBody `xml:"env:Body"`
 	}
 
-	bt, err := p.c.Call(context.Background(), req{
+	bt, err := p.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetObjectStatusBody{GetObjectStatus: GetObjectStatus{Pools: Pools{Item: pools}}},
 	})
@@ -402,3 +573,771 @@ func (p *PoolV2) GetObjectStatus(pools []PoolID) ([]common.ObjectStatus, error)
 
 	return res, nil
 }
+
+type longs struct {
+	Item []int64 `xml:"item"`
+}
+
+// validateMembers checks that the members of each pool fit its type.
+func validateMembers(pools []global_lb.PoolID, members [][]Member) error {
+	for i, pool := range pools {
+		if i >= len(members) {
+			break
+		}
+		for j, m := range members[i] {
+			if err := m.Validate(pool.PoolType); err != nil {
+				return fmt.Errorf("members of pool %d: item %d: %w", i, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+type addMemberReq struct {
+	soap.BaseEnvEnvelope
+	Body addMemberBody `xml:"env:Body"`
+}
+
+type addMemberBody struct {
+	AddMember addMember `xml:"tns:add_member"`
+}
+
+type addMember struct {
+	Pools   Pools                    `xml:"pools"`
+	Members common.Sequences[Member] `xml:"members"`
+}
+
+// AddMember
+// Introduced : BIG-IP_v12.0.0
+// Adds members to the specified pools. The members must fit the type of their pool,
+// see Member.Validate; the members of a pool are checked before the request is sent.
+func (p *PoolV2) AddMember(pools []global_lb.PoolID, members [][]Member) error {
+	return p.AddMemberCtx(context.Background(), pools, members)
+}
+
+// AddMemberCtx is the context-aware variant of AddMember.
+func (p *PoolV2) AddMemberCtx(ctx context.Context, pools []global_lb.PoolID, members [][]Member) error {
+
+	if err := validateMembers(pools, members); err != nil {
+		return err
+	}
+
+	_, err := p.c.Call(ctx, addMemberReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: addMemberBody{AddMember: addMember{
+			Pools:   Pools{Item: pools},
+			Members: common.NewSequences(members),
+		}},
+	})
+
+	return err
+}
+
+type removeMemberReq struct {
+	soap.BaseEnvEnvelope
+	Body removeMemberBody `xml:"env:Body"`
+}
+
+type removeMemberBody struct {
+	RemoveMember removeMember `xml:"tns:remove_member"`
+}
+
+type removeMember struct {
+	Pools   Pools                      `xml:"pools"`
+	Members common.Sequences[MemberID] `xml:"members"`
+}
+
+// RemoveMember
+// Introduced : BIG-IP_v12.0.0
+// Removes members from the specified pools.
+func (p *PoolV2) RemoveMember(pools []global_lb.PoolID, members [][]MemberID) error {
+	return p.RemoveMemberCtx(context.Background(), pools, members)
+}
+
+// RemoveMemberCtx is the context-aware variant of RemoveMember.
+func (p *PoolV2) RemoveMemberCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID) error {
+
+	_, err := p.c.Call(ctx, removeMemberReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: removeMemberBody{RemoveMember: removeMember{
+			Pools:   Pools{Item: pools},
+			Members: common.NewSequences(members),
+		}},
+	})
+
+	return err
+}
+
+type setMemberOrderReq struct {
+	soap.BaseEnvEnvelope
+	Body setMemberOrderBody `xml:"env:Body"`
+}
+
+type setMemberOrderBody struct {
+	SetMemberOrder setMemberOrder `xml:"tns:set_member_order"`
+}
+
+type setMemberOrder struct {
+	Pools   Pools                      `xml:"pools"`
+	Members common.Sequences[MemberID] `xml:"members"`
+	Orders  common.Sequences[int64]    `xml:"orders"`
+}
+
+// SetMemberOrder
+// Introduced : BIG-IP_v12.0.0
+// Sets the orders for the specified members of the specified pools.
+func (p *PoolV2) SetMemberOrder(pools []global_lb.PoolID, members [][]MemberID, orders [][]int64) error {
+	return p.SetMemberOrderCtx(context.Background(), pools, members, orders)
+}
+
+// SetMemberOrderCtx is the context-aware variant of SetMemberOrder.
+func (p *PoolV2) SetMemberOrderCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID, orders [][]int64) error {
+
+	_, err := p.c.Call(ctx, setMemberOrderReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setMemberOrderBody{SetMemberOrder: setMemberOrder{
+			Pools:   Pools{Item: pools},
+			Members: common.NewSequences(members),
+			Orders:  common.NewSequences(orders),
+		}},
+	})
+
+	return err
+}
+
+type setMemberRatioReq struct {
+	soap.BaseEnvEnvelope
+	Body setMemberRatioBody `xml:"env:Body"`
+}
+
+type setMemberRatioBody struct {
+	SetMemberRatio setMemberRatio `xml:"tns:set_member_ratio"`
+}
+
+type setMemberRatio struct {
+	Pools   Pools                      `xml:"pools"`
+	Members common.Sequences[MemberID] `xml:"members"`
+	Ratios  common.Sequences[int64]    `xml:"ratios"`
+}
+
+// SetMemberRatio
+// Introduced : BIG-IP_v12.0.0
+// Sets the ratios for the specified members of the specified pools.
+func (p *PoolV2) SetMemberRatio(pools []global_lb.PoolID, members [][]MemberID, ratios [][]int64) error {
+	return p.SetMemberRatioCtx(context.Background(), pools, members, ratios)
+}
+
+// SetMemberRatioCtx is the context-aware variant of SetMemberRatio.
+func (p *PoolV2) SetMemberRatioCtx(ctx context.Context, pools []global_lb.PoolID, members [][]MemberID, ratios [][]int64) error {
+
+	_, err := p.c.Call(ctx, setMemberRatioReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: setMemberRatioBody{SetMemberRatio: setMemberRatio{
+			Pools:   Pools{Item: pools},
+			Members: common.NewSequences(members),
+			Ratios:  common.NewSequences(ratios),
+		}},
+	})
+
+	return err
+}
+
+type lbMethods struct {
+	Item []global_lb.LBMethod `xml:"item"`
+}
+
+type states struct {
+	Item []common.EnabledState `xml:"item"`
+}
+
+type addresses struct {
+	Item []string `xml:"item"`
+}
+
+// MonitorAssociation
+// Introduced : BIG-IP_v12.0.0
+// The monitor rule of a typed pool.
+type MonitorAssociation struct {
+	Pool        global_lb.PoolID      `xml:"pool" json:"pool" yaml:"pool"`
+	MonitorRule global_lb.MonitorRule `xml:"monitor_rule" json:"monitor_rule" yaml:"monitor_rule"`
+}
+
+type createReq struct {
+	soap.BaseEnvEnvelope
+	Body createBody `xml:"env:Body"`
+}
+
+type createBody struct {
+	Create create `xml:"tns:create"`
+}
+
+type create struct {
+	Pools     Pools                    `xml:"pools"`
+	LBMethods lbMethods                `xml:"lb_methods"`
+	Members   common.Sequences[Member] `xml:"members"`
+}
+
+// Create
+// Introduced : BIG-IP_v12.0.0
+// Creates the specified typed pools with the given preferred load balancing methods and members.
+// The members must fit the type of their pool, see Member.Validate; they are checked before the request is sent.
+func (p *PoolV2) Create(pools []global_lb.PoolID, lbMethods []global_lb.LBMethod, members [][]Member) error {
+	return p.CreateCtx(context.Background(), pools, lbMethods, members)
+}
+
+// CreateCtx is the context-aware variant of Create.
+func (p *PoolV2) CreateCtx(ctx context.Context, pools []global_lb.PoolID, methods []global_lb.LBMethod, members [][]Member) error {
+
+	if err := validateMembers(pools, members); err != nil {
+		return err
+	}
+
+	_, err := p.c.Call(ctx, createReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body: createBody{Create: create{
+			Pools:     Pools{Item: pools},
+			LBMethods: lbMethods{Item: methods},
+			Members:   common.NewSequences(members),
+		}},
+	})
+
+	return err
+}
+
+type deletePoolReq struct {
+	soap.BaseEnvEnvelope
+	Body deletePoolBody `xml:"env:Body"`
+}
+
+type deletePoolBody struct {
+	DeletePool deletePool `xml:"tns:delete_pool"`
+}
+
+type deletePool struct {
+	Pools Pools `xml:"pools"`
+}
+
+// DeletePool
+// Introduced : BIG-IP_v12.0.0
+// Deletes the specified typed pools.
+func (p *PoolV2) DeletePool(pools []global_lb.PoolID) error {
+	return p.DeletePoolCtx(context.Background(), pools)
+}
+
+// DeletePoolCtx is the context-aware variant of DeletePool.
+func (p *PoolV2) DeletePoolCtx(ctx context.Context, pools []global_lb.PoolID) error {
+
+	_, err := p.c.Call(ctx, deletePoolReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            deletePoolBody{DeletePool: deletePool{Pools: Pools{Item: pools}}},
+	})
+
+	return err
+}
+
+type deleteAllPoolsReq struct {
+	soap.BaseEnvEnvelope
+	Body deleteAllPoolsBody `xml:"env:Body"`
+}
+
+type deleteAllPoolsBody struct {
+	DeleteAllPools struct{} `xml:"tns:delete_all_pools"`
+}
+
+// DeleteAllPools
+// Introduced : BIG-IP_v12.0.0
+// Deletes all typed pools, of every type.
+func (p *PoolV2) DeleteAllPools() error {
+	return p.DeleteAllPoolsCtx(context.Background())
+}
+
+// DeleteAllPoolsCtx is the context-aware variant of DeleteAllPools.
+func (p *PoolV2) DeleteAllPoolsCtx(ctx context.Context) error {
+
+	_, err := p.c.Call(ctx, deleteAllPoolsReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+	})
+
+	return err
+}
+
+type GetPreferredLBMethodBody struct {
+	GetPreferredLBMethod GetPreferredLBMethod `xml:"tns:get_preferred_lb_method"`
+}
+
+type GetPreferredLBMethod struct {
+	Pools Pools `xml:"pools"`
+}
+
+type PreferredLBMethodResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetPreferredLBMethodResponse struct {
+			Return struct {
+				Item []global_lb.LBMethod `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_preferred_lb_methodResponse"`
+	} `xml:"Body"`
+}
+
+// GetPreferredLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Gets the preferred load balancing methods of the specified pools.
+func (p *PoolV2) GetPreferredLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+	return p.GetPreferredLBMethodCtx(context.Background(), pools)
+}
+
+// GetPreferredLBMethodCtx is the context-aware variant of GetPreferredLBMethod.
+func (p *PoolV2) GetPreferredLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetPreferredLBMethodBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetPreferredLBMethodBody{GetPreferredLBMethod{Pools{Item: pools}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp PreferredLBMethodResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetPreferredLBMethodResponse.Return.Item, nil
+}
+
+type GetAlternateLBMethodBody struct {
+	GetAlternateLBMethod GetAlternateLBMethod `xml:"tns:get_alternate_lb_method"`
+}
+
+type GetAlternateLBMethod struct {
+	Pools Pools `xml:"pools"`
+}
+
+type AlternateLBMethodResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetAlternateLBMethodResponse struct {
+			Return struct {
+				Item []global_lb.LBMethod `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_alternate_lb_methodResponse"`
+	} `xml:"Body"`
+}
+
+// GetAlternateLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Gets the alternate load balancing methods of the specified pools.
+func (p *PoolV2) GetAlternateLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+	return p.GetAlternateLBMethodCtx(context.Background(), pools)
+}
+
+// GetAlternateLBMethodCtx is the context-aware variant of GetAlternateLBMethod.
+func (p *PoolV2) GetAlternateLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetAlternateLBMethodBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetAlternateLBMethodBody{GetAlternateLBMethod{Pools{Item: pools}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AlternateLBMethodResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetAlternateLBMethodResponse.Return.Item, nil
+}
+
+type GetFallbackLBMethodBody struct {
+	GetFallbackLBMethod GetFallbackLBMethod `xml:"tns:get_fallback_lb_method"`
+}
+
+type GetFallbackLBMethod struct {
+	Pools Pools `xml:"pools"`
+}
+
+type FallbackLBMethodResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetFallbackLBMethodResponse struct {
+			Return struct {
+				Item []global_lb.LBMethod `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_fallback_lb_methodResponse"`
+	} `xml:"Body"`
+}
+
+// GetFallbackLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Gets the fallback load balancing methods of the specified pools.
+func (p *PoolV2) GetFallbackLBMethod(pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+	return p.GetFallbackLBMethodCtx(context.Background(), pools)
+}
+
+// GetFallbackLBMethodCtx is the context-aware variant of GetFallbackLBMethod.
+func (p *PoolV2) GetFallbackLBMethodCtx(ctx context.Context, pools []global_lb.PoolID) ([]global_lb.LBMethod, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetFallbackLBMethodBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetFallbackLBMethodBody{GetFallbackLBMethod{Pools{Item: pools}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp FallbackLBMethodResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetFallbackLBMethodResponse.Return.Item, nil
+}
+
+type setLBMethod struct {
+	Pools     Pools     `xml:"pools"`
+	LBMethods lbMethods `xml:"lb_methods"`
+}
+
+type setPreferredLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setPreferredLBMethodBody `xml:"env:Body"`
+}
+
+type setPreferredLBMethodBody struct {
+	SetPreferredLBMethod setLBMethod `xml:"tns:set_preferred_lb_method"`
+}
+
+// SetPreferredLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Sets the preferred load balancing methods of the specified pools.
+func (p *PoolV2) SetPreferredLBMethod(pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+	return p.SetPreferredLBMethodCtx(context.Background(), pools, methods)
+}
+
+// SetPreferredLBMethodCtx is the context-aware variant of SetPreferredLBMethod.
+func (p *PoolV2) SetPreferredLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setPreferredLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setPreferredLBMethodBody{setLBMethod{Pools{Item: pools}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setAlternateLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setAlternateLBMethodBody `xml:"env:Body"`
+}
+
+type setAlternateLBMethodBody struct {
+	SetAlternateLBMethod setLBMethod `xml:"tns:set_alternate_lb_method"`
+}
+
+// SetAlternateLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Sets the alternate load balancing methods of the specified pools.
+func (p *PoolV2) SetAlternateLBMethod(pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+	return p.SetAlternateLBMethodCtx(context.Background(), pools, methods)
+}
+
+// SetAlternateLBMethodCtx is the context-aware variant of SetAlternateLBMethod.
+func (p *PoolV2) SetAlternateLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setAlternateLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setAlternateLBMethodBody{setLBMethod{Pools{Item: pools}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setFallbackLBMethodReq struct {
+	soap.BaseEnvEnvelope
+	Body setFallbackLBMethodBody `xml:"env:Body"`
+}
+
+type setFallbackLBMethodBody struct {
+	SetFallbackLBMethod setLBMethod `xml:"tns:set_fallback_lb_method"`
+}
+
+// SetFallbackLBMethod
+// Introduced : BIG-IP_v12.0.0
+// Sets the fallback load balancing methods of the specified pools.
+func (p *PoolV2) SetFallbackLBMethod(pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+	return p.SetFallbackLBMethodCtx(context.Background(), pools, methods)
+}
+
+// SetFallbackLBMethodCtx is the context-aware variant of SetFallbackLBMethod.
+func (p *PoolV2) SetFallbackLBMethodCtx(ctx context.Context, pools []global_lb.PoolID, methods []global_lb.LBMethod) error {
+
+	_, err := p.c.Call(ctx, setFallbackLBMethodReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setFallbackLBMethodBody{setLBMethod{Pools{Item: pools}, lbMethods{Item: methods}}},
+	})
+
+	return err
+}
+
+type setTTLReq struct {
+	soap.BaseEnvEnvelope
+	Body setTTLBody `xml:"env:Body"`
+}
+
+type setTTLBody struct {
+	SetTTL setTTL `xml:"tns:set_ttl"`
+}
+
+type setTTL struct {
+	Pools  Pools `xml:"pools"`
+	Values longs `xml:"values"`
+}
+
+// SetTTL
+// Introduced : BIG-IP_v12.0.0
+// Sets the TTL values of the specified pools.
+func (p *PoolV2) SetTTL(pools []global_lb.PoolID, values []int64) error {
+	return p.SetTTLCtx(context.Background(), pools, values)
+}
+
+// SetTTLCtx is the context-aware variant of SetTTL.
+func (p *PoolV2) SetTTLCtx(ctx context.Context, pools []global_lb.PoolID, values []int64) error {
+
+	_, err := p.c.Call(ctx, setTTLReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setTTLBody{setTTL{Pools{Item: pools}, longs{Item: values}}},
+	})
+
+	return err
+}
+
+type setAnswersToReturnReq struct {
+	soap.BaseEnvEnvelope
+	Body setAnswersToReturnBody `xml:"env:Body"`
+}
+
+type setAnswersToReturnBody struct {
+	SetAnswersToReturn setAnswersToReturn `xml:"tns:set_answers_to_return"`
+}
+
+type setAnswersToReturn struct {
+	Pools   Pools `xml:"pools"`
+	Answers longs `xml:"answers"`
+}
+
+// SetAnswersToReturn
+// Introduced : BIG-IP_v12.0.0
+// Sets the number of answers to return for the specified pools.
+func (p *PoolV2) SetAnswersToReturn(pools []global_lb.PoolID, answers []int64) error {
+	return p.SetAnswersToReturnCtx(context.Background(), pools, answers)
+}
+
+// SetAnswersToReturnCtx is the context-aware variant of SetAnswersToReturn.
+func (p *PoolV2) SetAnswersToReturnCtx(ctx context.Context, pools []global_lb.PoolID, answers []int64) error {
+
+	_, err := p.c.Call(ctx, setAnswersToReturnReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setAnswersToReturnBody{setAnswersToReturn{Pools{Item: pools}, longs{Item: answers}}},
+	})
+
+	return err
+}
+
+type setFallbackIPReq struct {
+	soap.BaseEnvEnvelope
+	Body setFallbackIPBody `xml:"env:Body"`
+}
+
+type setFallbackIPBody struct {
+	SetFallbackIP setFallbackIP `xml:"tns:set_fallback_ip"`
+}
+
+type setFallbackIP struct {
+	Pools Pools     `xml:"pools"`
+	IPs   addresses `xml:"ips"`
+}
+
+// SetFallbackIP
+// Introduced : BIG-IP_v12.0.0
+// Sets the fallback IP addresses of the specified pools, used by the EXPLICIT_IP fallback method.
+func (p *PoolV2) SetFallbackIP(pools []global_lb.PoolID, ips []string) error {
+	return p.SetFallbackIPCtx(context.Background(), pools, ips)
+}
+
+// SetFallbackIPCtx is the context-aware variant of SetFallbackIP.
+func (p *PoolV2) SetFallbackIPCtx(ctx context.Context, pools []global_lb.PoolID, ips []string) error {
+
+	_, err := p.c.Call(ctx, setFallbackIPReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setFallbackIPBody{setFallbackIP{Pools{Item: pools}, addresses{Item: ips}}},
+	})
+
+	return err
+}
+
+type setEnabledStateReq struct {
+	soap.BaseEnvEnvelope
+	Body setEnabledStateBody `xml:"env:Body"`
+}
+
+type setEnabledStateBody struct {
+	SetEnabledState setEnabledState `xml:"tns:set_enabled_state"`
+}
+
+type setEnabledState struct {
+	Pools  Pools  `xml:"pools"`
+	States states `xml:"states"`
+}
+
+// SetEnabledState
+// Introduced : BIG-IP_v12.0.0
+// Sets the enabled states of the specified pools.
+func (p *PoolV2) SetEnabledState(pools []global_lb.PoolID, values []common.EnabledState) error {
+	return p.SetEnabledStateCtx(context.Background(), pools, values)
+}
+
+// SetEnabledStateCtx is the context-aware variant of SetEnabledState.
+func (p *PoolV2) SetEnabledStateCtx(ctx context.Context, pools []global_lb.PoolID, values []common.EnabledState) error {
+
+	_, err := p.c.Call(ctx, setEnabledStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setEnabledStateBody{setEnabledState{Pools{Item: pools}, states{Item: values}}},
+	})
+
+	return err
+}
+
+type GetMonitorAssociationBody struct {
+	GetMonitorAssociation GetMonitorAssociation `xml:"tns:get_monitor_association"`
+}
+
+type GetMonitorAssociation struct {
+	Pools Pools `xml:"pools"`
+}
+
+type MonitorAssociationResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetMonitorAssociationResponse struct {
+			Return struct {
+				Item []MonitorAssociation `xml:"item"`
+			} `xml:"return"`
+		} `xml:"get_monitor_associationResponse"`
+	} `xml:"Body"`
+}
+
+// GetMonitorAssociation
+// Introduced : BIG-IP_v12.0.0
+// Gets the monitor associations of the specified pools.
+func (p *PoolV2) GetMonitorAssociation(pools []global_lb.PoolID) ([]MonitorAssociation, error) {
+	return p.GetMonitorAssociationCtx(context.Background(), pools)
+}
+
+// GetMonitorAssociationCtx is the context-aware variant of GetMonitorAssociation.
+func (p *PoolV2) GetMonitorAssociationCtx(ctx context.Context, pools []global_lb.PoolID) ([]MonitorAssociation, error) {
+
+	type req struct {
+		soap.BaseEnvEnvelope
+		Body GetMonitorAssociationBody `xml:"env:Body"`
+	}
+
+	bt, err := p.c.Call(ctx, req{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            GetMonitorAssociationBody{GetMonitorAssociation{Pools{Item: pools}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MonitorAssociationResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Body.GetMonitorAssociationResponse.Return.Item, nil
+}
+
+type setMonitorAssociationReq struct {
+	soap.BaseEnvEnvelope
+	Body setMonitorAssociationBody `xml:"env:Body"`
+}
+
+type setMonitorAssociationBody struct {
+	SetMonitorAssociation setMonitorAssociation `xml:"tns:set_monitor_association"`
+}
+
+type setMonitorAssociation struct {
+	MonitorAssociations struct {
+		Item []MonitorAssociation `xml:"item"`
+	} `xml:"monitor_associations"`
+}
+
+// SetMonitorAssociation
+// Introduced : BIG-IP_v12.0.0
+// Sets the monitor associations of the specified pools, replacing their current monitor rules.
+func (p *PoolV2) SetMonitorAssociation(monitorAssociations []MonitorAssociation) error {
+	return p.SetMonitorAssociationCtx(context.Background(), monitorAssociations)
+}
+
+// SetMonitorAssociationCtx is the context-aware variant of SetMonitorAssociation.
+func (p *PoolV2) SetMonitorAssociationCtx(ctx context.Context, monitorAssociations []MonitorAssociation) error {
+
+	var body setMonitorAssociation
+	body.MonitorAssociations.Item = monitorAssociations
+
+	_, err := p.c.Call(ctx, setMonitorAssociationReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setMonitorAssociationBody{SetMonitorAssociation: body},
+	})
+
+	return err
+}
+
+type removeMonitorAssociationReq struct {
+	soap.BaseEnvEnvelope
+	Body removeMonitorAssociationBody `xml:"env:Body"`
+}
+
+type removeMonitorAssociationBody struct {
+	RemoveMonitorAssociation removeMonitorAssociation `xml:"tns:remove_monitor_association"`
+}
+
+type removeMonitorAssociation struct {
+	Pools Pools `xml:"pools"`
+}
+
+// RemoveMonitorAssociation
+// Introduced : BIG-IP_v12.0.0
+// Removes the monitor associations of the specified pools, whose members then use the monitors of their servers.
+func (p *PoolV2) RemoveMonitorAssociation(pools []global_lb.PoolID) error {
+	return p.RemoveMonitorAssociationCtx(context.Background(), pools)
+}
+
+// RemoveMonitorAssociationCtx is the context-aware variant of RemoveMonitorAssociation.
+func (p *PoolV2) RemoveMonitorAssociationCtx(ctx context.Context, pools []global_lb.PoolID) error {
+
+	_, err := p.c.Call(ctx, removeMonitorAssociationReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            removeMonitorAssociationBody{RemoveMonitorAssociation: removeMonitorAssociation{Pools: Pools{Item: pools}}},
+	})
+
+	return err
+}
@@ -1,35 +1,95 @@
 package pool_v2
 
 import (
-	"crypto/tls"
+	"errors"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 	"github.com/wule61/go-f5-soap/global_lb"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.1.101.101/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{Name: "vs1", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "10.0.0.1", Port: 80}},
+		f5test.VirtualServer{Name: "vs2", Server: "/Common/bigip2", Destination: common.IPPortDefinition{Address: "10.0.0.2", Port: 80}},
+		f5test.VirtualServer{Name: "vs6", Server: "/Common/bigip1", Destination: common.IPPortDefinition{Address: "2001:db8::1", Port: 80}},
+	)
+	s.AddWideIPs(
+		f5test.WideIP{Name: "/Common/www.example.com"},
+		f5test.WideIP{Name: "/Common/*.example.net"},
 	)
+	s.AddPools(
+		f5test.Pool{
+			Name: "/Common/pool1",
+			TTL:  60,
+			Members: []f5test.PoolMember{
+				{Name: "vs1", Server: "/Common/bigip1"},
+				{Name: "vs2", Server: "/Common/bigip2"},
+			},
+		},
+		f5test.Pool{
+			Name:    "/Common/cname",
+			Type:    global_lb.GtmQueryTypeCname,
+			Members: []f5test.PoolMember{{Name: "www.example.com"}},
+		},
+		f5test.Pool{
+			Name:    "/Common/mx",
+			Type:    global_lb.GtmQueryTypeMX,
+			Enabled: common.StateDisabled,
+		},
+		f5test.Pool{
+			Name: "/Common/pool1",
+			Type: global_lb.GtmQueryTypeAAAA,
+		},
+		f5test.Pool{
+			Name:    "/Common/srv",
+			Type:    global_lb.GtmQueryTypeSRV,
+			Members: []f5test.PoolMember{{Name: "sip.example.com", Priority: 10, Weight: 20, Port: 5060}},
+		},
+		f5test.Pool{
+			Name:    "/Common/naptr",
+			Type:    global_lb.GtmQueryTypeNAPTR,
+			Members: []f5test.PoolMember{{Name: "sip.example.net", Flags: "s", Service: "SIP+D2U"}},
+		},
+	)
+
+	return s.Client()
 }
 
 func TestPoolV2_GetMember(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetMember([]PoolID{
-		{"/Common/pool1", "GTM_QUERY_TYPE_A"},
-		{"/Common/pool2", "GTM_QUERY_TYPE_A"},
-		{"/Common/cname", "GTM_QUERY_TYPE_CNAME"},
-		{"/Common/aaaa", "GTM_QUERY_TYPE_AAAA"},
-		{"/Common/pool1", "GTM_QUERY_TYPE_AAAA"},
+	arr, err := p.GetMember([]global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/cname", PoolType: global_lb.GtmQueryTypeCname},
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA},
+		{PoolName: "/Common/srv", PoolType: global_lb.GtmQueryTypeSRV},
+		{PoolName: "/Common/naptr", PoolType: global_lb.GtmQueryTypeNAPTR},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := [][]Member{
+		{{Name: "vs1", Server: "/Common/bigip1", Ratio: 1}, {Name: "vs2", Server: "/Common/bigip2", Ratio: 1}},
+		{{Name: "www.example.com", Ratio: 1, StaticTarget: common.StateDisabled}},
+		nil,
+		{{Name: "sip.example.com", Ratio: 1, Priority: 10, Weight: 20, Port: 5060}},
+		{{Name: "sip.example.net", Ratio: 1, Flags: "s", Service: "SIP+D2U"}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if _, err := p.GetMember([]global_lb.PoolID{{PoolName: "/Common/aaaa", PoolType: global_lb.GtmQueryTypeAAAA}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
 
 func TestPoolV2_GetListByType(t *testing.T) {
@@ -44,7 +104,14 @@ func TestPoolV2_GetListByType(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := [][]global_lb.PoolID{
+		{{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA}},
+		{{PoolName: "/Common/cname", PoolType: global_lb.GtmQueryTypeCname}},
+		{{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestPoolV2_GetList(t *testing.T) {
@@ -56,47 +123,478 @@ func TestPoolV2_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if len(arr) != 6 {
+		t.Fatalf("expected 6 pools, got %+v", arr)
+	}
 }
 
 func TestPoolV2_GetTTL(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetTTL([]PoolID{
-		{PoolName: "/Common/pool1", PoolType: "GTM_QUERY_TYPE_A"},
-		{PoolName: "/Common/mx", PoolType: "GTM_QUERY_TYPE_MX"},
+	arr, err := p.GetTTL([]global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/mx", PoolType: global_lb.GtmQueryTypeMX},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []int64{60, 30}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPoolV2_GetEnabledState(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetEnabledState([]PoolID{
-		{PoolName: "/Common/pool1", PoolType: "GTM_QUERY_TYPE_A"},
-		{PoolName: "/Common/mx", PoolType: "GTM_QUERY_TYPE_MX"},
+	arr, err := p.GetEnabledState([]global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/mx", PoolType: global_lb.GtmQueryTypeMX},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
 
 func TestPoolV2_GetObjectStatus(t *testing.T) {
 	p := New(newClient(t))
 
-	arr, err := p.GetObjectStatus([]PoolID{
-		{PoolName: "/Common/pool1", PoolType: "GTM_QUERY_TYPE_A"},
-		{PoolName: "/Common/mx", PoolType: "GTM_QUERY_TYPE_MX"},
+	arr, err := p.GetObjectStatus([]global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/mx", PoolType: global_lb.GtmQueryTypeMX},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(arr) != 2 || arr[0].EnabledStatus != common.EnabledStatusEnabled || arr[1].EnabledStatus != common.EnabledStatusDisabled {
+		t.Fatalf("unexpected statuses %+v", arr)
+	}
+}
+
+func TestMember_Validate(t *testing.T) {
+	vs := global_lb.VirtualServerID{Name: "vs1", Server: "/Common/bigip1"}
+
+	tests := []struct {
+		m     Member
+		t     global_lb.GTMQueryType
+		valid bool
+	}{
+		{VirtualServerMember(vs), global_lb.GtmQueryTypeA, true},
+		{VirtualServerMember(vs), global_lb.GtmQueryTypeAAAA, true},
+		{VirtualServerMember(vs), global_lb.GtmQueryTypeCname, false},
+		{CNAMEMember("www.example.com", true), global_lb.GtmQueryTypeCname, true},
+		{CNAMEMember("www.example.com", false), global_lb.GtmQueryTypeA, false},
+		{CNAMEMember("www.example.com", false), global_lb.GtmQueryTypeMX, false},
+		{MXMember("mail.example.com", 10), global_lb.GtmQueryTypeMX, true},
+		{MXMember("mail.example.com", 10), global_lb.GtmQueryTypeSRV, true},
+		{SRVMember("sip.example.com", 10, 20, 5060), global_lb.GtmQueryTypeSRV, true},
+		{SRVMember("sip.example.com", 10, 20, 5060), global_lb.GtmQueryTypeMX, false},
+		{NAPTRMember("sip.example.net", "s", "SIP+D2U", "", "."), global_lb.GtmQueryTypeNAPTR, true},
+		{NAPTRMember("sip.example.net", "s", "SIP+D2U", "", "."), global_lb.GtmQueryTypeCname, false},
+		{Member{Server: "/Common/bigip1"}, global_lb.GtmQueryTypeA, false},
+		{MXMember("mail.example.com", 10), global_lb.GtmQueryTypeUnknown, false},
+	}
+	for _, tt := range tests {
+		err := tt.m.Validate(tt.t)
+		if tt.valid && err != nil {
+			t.Errorf("%+v in a %s pool: unexpected error %v", tt.m, tt.t, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidMember) {
+			t.Errorf("%+v in a %s pool: expected ErrInvalidMember, got %v", tt.m, tt.t, err)
+		}
+	}
+}
+
+func TestPoolV2_AddMember(t *testing.T) {
+	p := New(newClient(t))
+
+	a := global_lb.PoolID{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA}
+	cname := global_lb.PoolID{PoolName: "/Common/cname", PoolType: global_lb.GtmQueryTypeCname}
+	mx := global_lb.PoolID{PoolName: "/Common/mx", PoolType: global_lb.GtmQueryTypeMX}
+
+	vs6 := VirtualServerMember(global_lb.VirtualServerID{Name: "vs6", Server: "/Common/bigip1"})
+	vs6.Order = 1
+	err := p.AddMember([]global_lb.PoolID{a, cname, mx}, [][]Member{
+		{vs6},
+		{CNAMEMember("cdn.example.org", true)},
+		{MXMember("mail.example.net", 10)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMember([]global_lb.PoolID{a, cname, mx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]Member{
+		{{Name: "vs6", Server: "/Common/bigip1", Order: 1, Ratio: 1}},
+		{
+			{Name: "www.example.com", Ratio: 1, StaticTarget: common.StateDisabled},
+			{Name: "cdn.example.org", Ratio: 1, StaticTarget: common.StateEnabled},
+		},
+		{{Name: "mail.example.net", Ratio: 1, Priority: 10}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	// A member not fitting its pool is rejected before the request is sent.
+	if err := p.AddMember([]global_lb.PoolID{mx}, [][]Member{{vs6}}); !errors.Is(err, ErrInvalidMember) {
+		t.Fatalf("expected ErrInvalidMember, got %v", err)
+	}
+
+	// A non-terminal member needs a wide IP, unless it is a CNAME member with a static target.
+	if err := p.AddMember([]global_lb.PoolID{cname}, [][]Member{{CNAMEMember("cdn.example.org.test", false)}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if err := p.AddMember([]global_lb.PoolID{a}, [][]Member{{
+		VirtualServerMember(global_lb.VirtualServerID{Name: "vs9", Server: "/Common/bigip1"}),
+	}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if err := p.AddMember([]global_lb.PoolID{a}, [][]Member{{vs6}}); !soap.IsAlreadyExists(err) {
+		t.Fatalf("expected an already exists fault, got %v", err)
+	}
+}
+
+func TestPoolV2_RemoveMember(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/cname", PoolType: global_lb.GtmQueryTypeCname},
+	}
+	err := p.RemoveMember(pools, [][]MemberID{
+		{{Name: "vs1", Server: "/Common/bigip1"}},
+		{{Name: "www.example.com"}},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	arr, err := p.GetMember(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]Member{{{Name: "vs2", Server: "/Common/bigip2", Ratio: 1}}, nil}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.RemoveMember(pools[:1], [][]MemberID{{{Name: "vs1", Server: "/Common/bigip1"}}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolV2_SetMemberOrderAndRatio(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/srv", PoolType: global_lb.GtmQueryTypeSRV},
+	}
+	members := [][]MemberID{
+		{{Name: "vs2", Server: "/Common/bigip2"}, {Name: "vs1", Server: "/Common/bigip1"}},
+		{{Name: "sip.example.com"}},
+	}
+	if err := p.SetMemberOrder(pools, members, [][]int64{{0, 1}, {2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetMemberRatio(pools, members, [][]int64{{3, 4}, {5}}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMember(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]Member{
+		{{Name: "vs1", Server: "/Common/bigip1", Order: 1, Ratio: 4}, {Name: "vs2", Server: "/Common/bigip2", Ratio: 3}},
+		{{Name: "sip.example.com", Order: 2, Ratio: 5, Priority: 10, Weight: 20, Port: 5060}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.SetMemberRatio(pools[:1], members[:1], [][]int64{{1}}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+	if err := p.SetMemberOrder(pools[1:], [][]MemberID{{{Name: "www.example.com"}}}, [][]int64{{1}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolV2_Create(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool2", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/pool2", PoolType: global_lb.GtmQueryTypeAAAA},
+	}
+	err := p.Create(pools, []global_lb.LBMethod{global_lb.LBMethodRatio, global_lb.LBMethodGlobalAvailability}, [][]Member{
+		{VirtualServerMember(global_lb.VirtualServerID{Name: "vs1", Server: "/Common/bigip1"})},
+		{VirtualServerMember(global_lb.VirtualServerID{Name: "vs6", Server: "/Common/bigip1"})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	methods, err := p.GetPreferredLBMethod(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []global_lb.LBMethod{global_lb.LBMethodRatio, global_lb.LBMethodGlobalAvailability}; !reflect.DeepEqual(methods, want) {
+		t.Fatalf("expected %v, got %v", want, methods)
+	}
+
+	arr, err := p.GetMember(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]Member{
+		{{Name: "vs1", Server: "/Common/bigip1", Ratio: 1}},
+		{{Name: "vs6", Server: "/Common/bigip1", Ratio: 1}},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.Create(pools[1:], []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, [][]Member{nil}); !soap.IsAlreadyExists(err) {
+		t.Fatalf("expected an already exists fault, got %v", err)
+	}
+	mx := []global_lb.PoolID{{PoolName: "/Common/pool2", PoolType: global_lb.GtmQueryTypeMX}}
+	if err := p.Create(mx, []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, [][]Member{{want[0][0]}}); !errors.Is(err, ErrInvalidMember) {
+		t.Fatalf("expected ErrInvalidMember, got %v", err)
+	}
+
+	// The pool is not created when one of its members cannot be added.
+	a := []global_lb.PoolID{{PoolName: "/Common/pool3", PoolType: global_lb.GtmQueryTypeA}}
+	err = p.Create(a, []global_lb.LBMethod{global_lb.LBMethodRoundRobin}, [][]Member{{
+		VirtualServerMember(global_lb.VirtualServerID{Name: "vs9", Server: "/Common/bigip1"}),
+	}})
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if _, err := p.GetTTL(a); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolV2_DeletePool(t *testing.T) {
+	p := New(newClient(t))
+
+	aaaa := global_lb.PoolID{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA}
+	if err := p.DeletePool([]global_lb.PoolID{aaaa}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetListByType([]global_lb.GTMQueryType{global_lb.GtmQueryTypeA, global_lb.GtmQueryTypeAAAA})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]global_lb.PoolID{{{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA}}, nil}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	// Deleting a missing pool leaves the others in place.
+	err = p.DeletePool([]global_lb.PoolID{{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA}, aaaa})
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if _, err := p.GetTTL(want[0]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPoolV2_DeleteAllPools(t *testing.T) {
+	p := New(newClient(t))
+
+	if err := p.DeleteAllPools(); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 0 {
+		t.Fatalf("expected no pools, got %+v", arr)
+	}
+}
+
+func TestPoolV2_SetLBMethod(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA},
+	}
+	tests := []struct {
+		set  func([]global_lb.PoolID, []global_lb.LBMethod) error
+		get  func([]global_lb.PoolID) ([]global_lb.LBMethod, error)
+		want []global_lb.LBMethod
+	}{
+		{p.SetPreferredLBMethod, p.GetPreferredLBMethod, []global_lb.LBMethod{global_lb.LBMethodTopology, global_lb.LBMethodRatio}},
+		{p.SetAlternateLBMethod, p.GetAlternateLBMethod, []global_lb.LBMethod{global_lb.LBMethodStaticPersist, global_lb.LBMethodNULL}},
+		{p.SetFallbackLBMethod, p.GetFallbackLBMethod, []global_lb.LBMethod{global_lb.LBMethodDropPacket, global_lb.LBMethodReturnToDNS}},
+	}
+	for _, tt := range tests {
+		if err := tt.set(pools, tt.want); err != nil {
+			t.Fatal(err)
+		}
+		arr, err := tt.get(pools)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(arr, tt.want) {
+			t.Fatalf("expected %v, got %v", tt.want, arr)
+		}
+	}
+
+	if err := p.SetPreferredLBMethod(pools[1:], []global_lb.LBMethod{"LB_METHOD_AAAA"}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPoolV2_SetTTL(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA},
+	}
+	if err := p.SetTTL(pools, []int64{120, 300}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetTTL(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{120, 300}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetTTL(pools, []int64{60}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPoolV2_SetAnswersToReturnAndFallbackIP(t *testing.T) {
+	c := newClient(t)
+	p := New(c)
+
+	a := []global_lb.PoolID{{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA}}
+	if err := p.SetAnswersToReturn(a, []int64{3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SetFallbackIP(a, []string{"192.0.2.1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The A pools are also served by the Pool interface.
+	answers, err := pool.New(c).GetAnswersToReturn([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{3}; !reflect.DeepEqual(answers, want) {
+		t.Fatalf("expected %v, got %v", want, answers)
+	}
+	ips, err := pool.New(c).GetFallbackIP([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"192.0.2.1"}; !reflect.DeepEqual(ips, want) {
+		t.Fatalf("expected %v, got %v", want, ips)
+	}
+
+	aaaa := []global_lb.PoolID{{PoolName: "/Common/aaaa", PoolType: global_lb.GtmQueryTypeAAAA}}
+	if err := p.SetAnswersToReturn(aaaa, []int64{3}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if err := p.SetFallbackIP(aaaa, []string{"2001:db8::1"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+}
+
+func TestPoolV2_SetEnabledState(t *testing.T) {
+	p := New(newClient(t))
+
+	pools := []global_lb.PoolID{
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA},
+		{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA},
+	}
+	if err := p.SetEnabledState(pools[1:], []common.EnabledState{common.StateDisabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetEnabledState(pools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
+
+	if err := p.SetEnabledState(pools[1:], []common.EnabledState{"STATE_AAAA"}); !soap.IsInvalidArgument(err) {
+		t.Fatalf("expected an invalid argument fault, got %v", err)
+	}
+}
+
+func TestPoolV2_MonitorAssociation(t *testing.T) {
+	p := New(newClient(t))
+
+	a := global_lb.PoolID{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeA}
+	aaaa := global_lb.PoolID{PoolName: "/Common/pool1", PoolType: global_lb.GtmQueryTypeAAAA}
+	want := []MonitorAssociation{
+		{
+			Pool:        a,
+			MonitorRule: global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/http"}},
+		},
+		{
+			Pool: aaaa,
+			MonitorRule: global_lb.MonitorRule{
+				Type:             global_lb.MonitorRuleTypeMOfN,
+				Quorum:           1,
+				MonitorTemplates: []string{"/Common/http", "/Common/tcp"},
+			},
+		},
+	}
+	if err := p.SetMonitorAssociation(want); err != nil {
+		t.Fatal(err)
+	}
+
+	arr, err := p.GetMonitorAssociation([]global_lb.PoolID{a, aaaa})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if err := p.RemoveMonitorAssociation([]global_lb.PoolID{aaaa}); err != nil {
+		t.Fatal(err)
+	}
+	arr, err = p.GetMonitorAssociation([]global_lb.PoolID{aaaa})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []MonitorAssociation{{Pool: aaaa, MonitorRule: global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeNone}}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	missing := []MonitorAssociation{{
+		Pool:        aaaa,
+		MonitorRule: global_lb.MonitorRule{Type: global_lb.MonitorRuleTypeSingle, MonitorTemplates: []string{"/Common/aaaa"}},
+	}}
+	if err := p.SetMonitorAssociation(missing); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
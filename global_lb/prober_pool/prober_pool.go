@@ -21,8 +21,11 @@ const tns = "urn:iControl:GlobalLB/ProberPool"
 // the load balancing method selected for the prober pool (e.g., round robin or global availability).
 type IProberPool interface {
 	GetList() ([]string, error)
+	GetListCtx(ctx context.Context) ([]string, error)
 	GetMember(pools []string) ([][]string, error)
+	GetMemberCtx(ctx context.Context, pools []string) ([][]string, error)
 	GetMemberOrder(pools []string, members [][]string) ([][]int64, error)
+	GetMemberOrderCtx(ctx context.Context, pools []string, members [][]string) ([][]int64, error)
 }
 
 var _ IProberPool = (*ProberPool)(nil)
@@ -56,8 +59,13 @@ type getListResp struct {
 }
 
 func (p *ProberPool) GetList() ([]string, error) {
+	return p.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (p *ProberPool) GetListCtx(ctx context.Context) ([]string, error) {
 
-	bt, err := p.c.Call(context.Background(), getListReq{
+	bt, err := p.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{},
 	})
@@ -102,8 +110,13 @@ type getMemberResp struct {
 }
 
 func (p *ProberPool) GetMember(pools []string) ([][]string, error) {
+	return p.GetMemberCtx(context.Background(), pools)
+}
 
-	bt, err := p.c.Call(context.Background(), getMemberReq{
+// GetMemberCtx is the context-aware variant of GetMember.
+func (p *ProberPool) GetMemberCtx(ctx context.Context, pools []string) ([][]string, error) {
+
+	bt, err := p.c.Call(ctx, getMemberReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getMemberBody{GetMember: getMember{Pools: struct {
 			Item []string `xml:"item"`
@@ -164,6 +177,11 @@ type getMemberOrderResp struct {
 }
 
 func (p *ProberPool) GetMemberOrder(pools []string, members [][]string) ([][]int64, error) {
+	return p.GetMemberOrderCtx(context.Background(), pools, members)
+}
+
+// GetMemberOrderCtx is the context-aware variant of GetMemberOrder.
+func (p *ProberPool) GetMemberOrderCtx(ctx context.Context, pools []string, members [][]string) ([][]int64, error) {
 
 	var memberItem []Item
 	for _, v := range members {
@@ -172,7 +190,7 @@ func (p *ProberPool) GetMemberOrder(pools []string, members [][]string) ([][]int
 		memberItem = append(memberItem, item)
 	}
 
-	bt, err := p.c.Call(context.Background(), getMemberOrderReq{
+	bt, err := p.c.Call(ctx, getMemberOrderReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getMemberOrderBody{GetMemberOrder: getMemberOrder{
 			Pools: struct {
@@ -1,17 +1,22 @@
 package prober_pool
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://202.173.9.33/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddProberPools(
+		f5test.ProberPool{Name: "/Common/prober_sh", Members: []string{"/Common/bigip1", "/Common/bigip2"}},
+		f5test.ProberPool{Name: "/Common/prober_bj", Members: []string{"/Common/bigip3"}},
 	)
+
+	return s.Client()
 }
 
 func TestProberPool_GetList(t *testing.T) {
@@ -23,7 +28,9 @@ func TestProberPool_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(list)
+	if want := []string{"/Common/prober_sh", "/Common/prober_bj"}; !reflect.DeepEqual(list, want) {
+		t.Fatalf("expected %v, got %v", want, list)
+	}
 }
 
 func TestProberPool_GetMember(t *testing.T) {
@@ -40,10 +47,16 @@ func TestProberPool_GetMember(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if want := [][]string{{"/Common/bigip1", "/Common/bigip2"}, {"/Common/bigip3"}}; !reflect.DeepEqual(members, want) {
+		t.Fatalf("expected %v, got %v", want, members)
+	}
+
 	orders, err := p.GetMemberOrder(list, members)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(orders)
+	if want := [][]int64{{0, 1}, {0}}; !reflect.DeepEqual(orders, want) {
+		t.Fatalf("expected %v, got %v", want, orders)
+	}
 }
@@ -15,7 +15,9 @@ const tns = "urn:iControl:GlobalLB/Region"
 // The Region interface enables you to work with user-defined region definitions.
 type IRegion interface {
 	GetList() ([]RegionDefinition, error)
+	GetListCtx(ctx context.Context) ([]RegionDefinition, error)
 	GetRegionItem([]RegionDefinition) ([][]RegionItem, error)
+	GetRegionItemCtx(context.Context, []RegionDefinition) ([][]RegionItem, error)
 }
 
 var _ IRegion = (*Region)(nil)
@@ -24,17 +26,17 @@ var _ IRegion = (*Region)(nil)
 // Introduced : BIG-IP_v9.2.0
 // A struct that describes a region definition.
 type RegionDefinition struct {
-	Name   string                 `xml:"name"`    // The region name.
-	DBType global_lb.RegionDBType `xml:"db_type"` // The region’s database type.
+	Name   string                 `xml:"name" json:"name" yaml:"name"`          // The region name.
+	DBType global_lb.RegionDBType `xml:"db_type" json:"db_type" yaml:"db_type"` // The region’s database type.
 }
 
 // RegionItem
 //Introduced : BIG-IP_v9.2.0
 //A struct that describes a region item.
 type RegionItem struct {
-	Content string               // The region item’s content.
-	Type    global_lb.RegionType // The region type.
-	Negate  bool                 // The state indicating whether the region member to be interpreted as not equal to the region member options selected.
+	Content string               `json:"content" yaml:"content"` // The region item’s content.
+	Type    global_lb.RegionType `json:"type" yaml:"type"`       // The region type.
+	Negate  bool                 `json:"negate" yaml:"negate"`   // The state indicating whether the region member to be interpreted as not equal to the region member options selected.
 }
 
 type Region struct {
@@ -76,8 +78,13 @@ type getListResp struct {
 //Introduced : BIG-IP_v9.2.0
 //Gets a list of of region definitions.
 func (r *Region) GetList() ([]RegionDefinition, error) {
+	return r.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (r *Region) GetListCtx(ctx context.Context) ([]RegionDefinition, error) {
 
-	bt, err := r.c.Call(context.Background(), getListReq{
+	bt, err := r.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{GetList: struct{}{}},
 	})
@@ -146,8 +153,13 @@ type getRegionItemResp struct {
 // Introduced : BIG-IP_v9.2.0
 // Gets the list of region items that define the specified regions.
 func (r *Region) GetRegionItem(definitions []RegionDefinition) ([][]RegionItem, error) {
+	return r.GetRegionItemCtx(context.Background(), definitions)
+}
+
+// GetRegionItemCtx is the context-aware variant of GetRegionItem.
+func (r *Region) GetRegionItemCtx(ctx context.Context, definitions []RegionDefinition) ([][]RegionItem, error) {
 
-	bt, err := r.c.Call(context.Background(), getRegionItemBodyReq{
+	bt, err := r.c.Call(ctx, getRegionItemBodyReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getRegionItemBody{GetRegionItem: getRegionItem{Regions: Regions{Item: definitions}}},
 	})
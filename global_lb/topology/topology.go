@@ -16,7 +16,9 @@ const tns = "urn:iControl:GlobalLB/Topology"
 // or remove virtual server entries from, a topology.
 type ITopology interface {
 	GetList() ([]TopologyRecord, error)
+	GetListCtx(ctx context.Context) ([]TopologyRecord, error)
 	GetOrder(records []TopologyRecord) ([]int64, error)
+	GetOrderCtx(ctx context.Context, records []TopologyRecord) ([]int64, error)
 }
 
 var _ ITopology = (*Topology)(nil)
@@ -30,14 +32,14 @@ func New(c *soap.Client) *Topology {
 }
 
 type TopologyRecord struct {
-	Server TopologyEndpoint
-	LDns   TopologyEndpoint
+	Server TopologyEndpoint `json:"server" yaml:"server"`
+	LDns   TopologyEndpoint `json:"ldns" yaml:"ldns"`
 }
 
 type TopologyEndpoint struct {
-	Type    global_lb.RegionType
-	Content string
-	Negate  bool
+	Type    global_lb.RegionType `json:"type" yaml:"type"`
+	Content string               `json:"content" yaml:"content"`
+	Negate  bool                 `json:"negate" yaml:"negate"`
 }
 
 type GetListBody struct {
@@ -64,13 +66,18 @@ type ListResp struct {
 
 // GetList Gets a list of of topology records.
 func (t *Topology) GetList() ([]TopologyRecord, error) {
+	return t.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (t *Topology) GetListCtx(ctx context.Context) ([]TopologyRecord, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetListBody `xml:"env:Body"`
 	}
 
-	bt, err := t.c.Call(context.Background(), req{
+	bt, err := t.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetListBody{GetList: struct{}{}},
 	})
@@ -111,13 +118,18 @@ type OrderResp struct {
 
 // GetOrder Gets the sort orders for the specified topology records.
 func (t *Topology) GetOrder(records []TopologyRecord) ([]int64, error) {
+	return t.GetOrderCtx(context.Background(), records)
+}
+
+// GetOrderCtx is the context-aware variant of GetOrder.
+func (t *Topology) GetOrderCtx(ctx context.Context, records []TopologyRecord) ([]int64, error) {
 
 	type Req struct {
 		soap.BaseEnvEnvelope
 		Body GetOrderBody `xml:"env:Body"`
 	}
 
-	bt, err := t.c.Call(context.Background(), Req{
+	bt, err := t.c.Call(ctx, Req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetOrderBody{GetOrder: GetOrder{Records: Records{Item: records}}},
 	})
@@ -12,8 +12,8 @@ import (
 const tns = "urn:iControl:GlobalLB/VirtualServer"
 
 type MonitorAssociation struct {
-	VirtualServer global_lb.VirtualServerDefinition
-	MonitorRule   global_lb.MonitorRule
+	VirtualServer global_lb.VirtualServerDefinition `json:"virtual_server" yaml:"virtual_server"`
+	MonitorRule   global_lb.MonitorRule             `json:"monitor_rule" yaml:"monitor_rule"`
 }
 
 type VirtualServers struct {
@@ -22,8 +22,11 @@ type VirtualServers struct {
 
 type IVirtualServer interface {
 	GetList() ([]global_lb.VirtualServerDefinition, error)
+	GetListCtx(ctx context.Context) ([]global_lb.VirtualServerDefinition, error)
 	GetMonitorAssociation([]global_lb.VirtualServerDefinition) ([]MonitorAssociation, error)
+	GetMonitorAssociationCtx(context.Context, []global_lb.VirtualServerDefinition) ([]MonitorAssociation, error)
 	GetServer([]global_lb.VirtualServerDefinition) ([]string, error)
+	GetServerCtx(context.Context, []global_lb.VirtualServerDefinition) ([]string, error)
 }
 
 var _ IVirtualServer = (*VirtualServer)(nil)
@@ -62,12 +65,17 @@ type getListResp struct {
 }
 
 func (v *VirtualServer) GetList() ([]global_lb.VirtualServerDefinition, error) {
+	return v.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (v *VirtualServer) GetListCtx(ctx context.Context) ([]global_lb.VirtualServerDefinition, error) {
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetListBody `xml:"env:Body"`
 	}
 
-	bt, err := v.c.Call(context.Background(), req{
+	bt, err := v.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetListBody{GetList: struct{}{}},
 	})
@@ -112,13 +120,22 @@ type getServersResp struct {
 }
 
 func (v *VirtualServer) GetServer(virtualServers []global_lb.VirtualServerDefinition) ([]string, error) {
+	return v.GetServerCtx(context.Background(), virtualServers)
+}
+
+// GetServerCtx is the context-aware variant of GetServer.
+func (v *VirtualServer) GetServerCtx(ctx context.Context, virtualServers []global_lb.VirtualServerDefinition) ([]string, error) {
 
 	type Req struct {
 		soap.BaseEnvEnvelope
 		Body GetServerBody `xml:"env:Body"`
 	}
 
-	bt, err := v.c.Call(context.Background(), Req{
+	if err := global_lb.ValidateDefinitions(virtualServers); err != nil {
+		return nil, err
+	}
+
+	bt, err := v.c.Call(ctx, Req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetServerBody{GetServer: GetServer{
 			VirtualServers: VirtualServers{
@@ -188,13 +205,22 @@ type getMonitorAssociationResp struct {
 }
 
 func (v *VirtualServer) GetMonitorAssociation(virtualServers []global_lb.VirtualServerDefinition) ([]MonitorAssociation, error) {
+	return v.GetMonitorAssociationCtx(context.Background(), virtualServers)
+}
+
+// GetMonitorAssociationCtx is the context-aware variant of GetMonitorAssociation.
+func (v *VirtualServer) GetMonitorAssociationCtx(ctx context.Context, virtualServers []global_lb.VirtualServerDefinition) ([]MonitorAssociation, error) {
 
 	type Req struct {
 		soap.BaseEnvEnvelope
 		Body GetMonitorAssociationBody `xml:"env:Body"`
 	}
 
-	bt, err := v.c.Call(context.Background(), Req{
+	if err := global_lb.ValidateDefinitions(virtualServers); err != nil {
+		return nil, err
+	}
+
+	bt, err := v.c.Call(ctx, Req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetMonitorAssociationBody{GetMonitorAssociation: GetMonitorAssociation{
 			VirtualServers: VirtualServers{
@@ -1,19 +1,36 @@
 package virtual_server
 
 import (
-	"crypto/tls"
+	"reflect"
+	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 	"github.com/wule61/go-f5-soap/global_lb"
-
-	"testing"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(
+		f5test.VirtualServer{
+			Name:        "vs_10_1_1_80_80",
+			Server:      "/Common/bigip1",
+			Destination: common.IPPortDefinition{Address: "10.1.1.80", Port: 80},
+			Monitor: global_lb.MonitorRule{
+				Type:             global_lb.MonitorRuleTypeSingle,
+				MonitorTemplates: []string{"/Common/tcp"},
+			},
+		},
+		f5test.VirtualServer{
+			Name:        "vs_10_1_1_80_2000",
+			Server:      "/Common/bigip2",
+			Destination: common.IPPortDefinition{Address: "10.1.1.80", Port: 2000},
+		},
 	)
+
+	return s.Client()
 }
 
 func TestVirtualServer_GetList(t *testing.T) {
@@ -24,7 +41,13 @@ func TestVirtualServer_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	want := []global_lb.VirtualServerDefinition{
+		{Name: "vs_10_1_1_80_80", Address: "10.1.1.80", Port: 80},
+		{Name: "vs_10_1_1_80_2000", Address: "10.1.1.80", Port: 2000},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestVirtualServer_GetMonitorAssociation(t *testing.T) {
@@ -32,25 +55,36 @@ func TestVirtualServer_GetMonitorAssociation(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetMonitorAssociation([]global_lb.VirtualServerDefinition{
-		{Name: "vs_84_147_225_211_3306", Address: "84.147.225.211", Port: 3306},
+		{Name: "vs_10_1_1_80_80", Address: "10.1.1.80", Port: 80},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	want := []MonitorAssociation{{
+		VirtualServer: global_lb.VirtualServerDefinition{Name: "vs_10_1_1_80_80", Address: "10.1.1.80", Port: 80},
+		MonitorRule: global_lb.MonitorRule{
+			Type:             global_lb.MonitorRuleTypeSingle,
+			MonitorTemplates: []string{"/Common/tcp"},
+		},
+	}}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
 
 func TestVirtualServer_GetServer(t *testing.T) {
 	p := New(newClient(t))
 	arr, err := p.GetServer([]global_lb.VirtualServerDefinition{
-		{Name: "vs_76_33_110_80_2000", Address: "76.33.110.80", Port: 2000},
-		{Name: "vs_76_33_110_80_80", Address: "76.33.110.80", Port: 80},
+		{Name: "vs_10_1_1_80_2000", Address: "10.1.1.80", Port: 2000},
+		{Name: "vs_10_1_1_80_80", Address: "10.1.1.80", Port: 80},
 	})
 
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []string{"/Common/bigip2", "/Common/bigip1"}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %v, got %v", want, arr)
+	}
 }
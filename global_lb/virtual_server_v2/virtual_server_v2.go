@@ -15,6 +15,7 @@ const tns = "urn:iControl:GlobalLB/VirtualServerV2"
 //The VirtualServer interface enables you to work with virtual servers associated with a server.
 type IVirtualServerV2 interface {
 	GetAddress(virtualServers []VirtualServerID) ([]common.IPPortDefinition, error)
+	GetAddressCtx(ctx context.Context, virtualServers []VirtualServerID) ([]common.IPPortDefinition, error)
 }
 
 var _ IVirtualServerV2 = (*VirtualServerV2)(nil)
@@ -28,8 +29,8 @@ func New(c *soap.Client) *VirtualServerV2 {
 }
 
 type VirtualServerID struct {
-	Name   string `xml:"name"`
-	Server string `xml:"server"`
+	Name   string `xml:"name" json:"name" yaml:"name"`
+	Server string `xml:"server" json:"server" yaml:"server"`
 }
 
 type GetAddressBody struct {
@@ -65,13 +66,18 @@ type AddressResp struct {
 // GetAddress Gets the IP address and service associated with a set of virtual servers.
 // Note: A set_address method is not supported.
 func (v *VirtualServerV2) GetAddress(virtualServers []VirtualServerID) ([]common.IPPortDefinition, error) {
+	return v.GetAddressCtx(context.Background(), virtualServers)
+}
+
+// GetAddressCtx is the context-aware variant of GetAddress.
+func (v *VirtualServerV2) GetAddressCtx(ctx context.Context, virtualServers []VirtualServerID) ([]common.IPPortDefinition, error) {
 
 	type req struct {
 		soap.BaseEnvEnvelope
 		Body GetAddressBody `xml:"env:Body"`
 	}
 
-	bt, err := v.c.Call(context.Background(), req{
+	bt, err := v.c.Call(ctx, req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            GetAddressBody{GetAddress: GetAddress{VirtualServers: VirtualServers{Item: virtualServers}}},
 	})
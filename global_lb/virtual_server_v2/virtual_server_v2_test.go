@@ -1,28 +1,41 @@
 package virtual_server_v2
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
-	)
+
+	s := f5test.NewServer(t)
+	s.AddVirtualServers(f5test.VirtualServer{
+		Name:        "vs_10_1_1_80_2000",
+		Server:      "/Common/bigip1",
+		Destination: common.IPPortDefinition{Address: "10.1.1.80", Port: 2000},
+	})
+
+	return s.Client()
 }
 
 func TestVirtualServerV2_GetAddress(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetAddress([]VirtualServerID{
-		{"vs_76_33_110_80_2000", "/Common/A1_AMC-ENTEGOR_AMC-APP-ENT"},
+		{"vs_10_1_1_80_2000", "/Common/bigip1"},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(arr)
+	if want := []common.IPPortDefinition{{Address: "10.1.1.80", Port: 2000}}; !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if _, err := p.GetAddress([]VirtualServerID{{"vs_10_1_1_80_2000", "/Common/bigip2"}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
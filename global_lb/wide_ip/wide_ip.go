@@ -19,19 +19,24 @@ const tns = "urn:iControl:GlobalLB/WideIP"
 // to get a list of wide IPs, to add a wide IP, or to remove a wide IP.
 type IWideIP interface {
 	GetList() (wideIPs []string, err error)
+	GetListCtx(ctx context.Context) (wideIPs []string, err error)
 	GetWideIpPool(wideIPs []string) ([][]WideIPPool, error)
+	GetWideIpPoolCtx(ctx context.Context, wideIPs []string) ([][]WideIPPool, error)
 	GetLBMethod(wideIPs []string) ([]global_lb.LBMethod, error)
+	GetLBMethodCtx(ctx context.Context, wideIPs []string) ([]global_lb.LBMethod, error)
 	GetObjectStatus(wideIPs []string) ([]common.ObjectStatus, error)
+	GetObjectStatusCtx(ctx context.Context, wideIPs []string) ([]common.ObjectStatus, error)
 	GetEnabledState(wideIPs []string) ([]common.EnabledState, error)
+	GetEnabledStateCtx(ctx context.Context, wideIPs []string) ([]common.EnabledState, error)
 }
 
 // WideIPPool
 // Introduced : BIG-IP_v9.2.0
 // A struct that describes a wide IP&aposs pool.
 type WideIPPool struct {
-	PoolName string // The pool name.
-	Order    int64  // The order given to the specified pool.
-	Ratio    int64  // The ratio given to the specified pool.
+	PoolName string `json:"pool_name" yaml:"pool_name"` // The pool name.
+	Order    int64  `json:"order" yaml:"order"`         // The order given to the specified pool.
+	Ratio    int64  `json:"ratio" yaml:"ratio"`         // The ratio given to the specified pool.
 }
 
 var _ IWideIP = (*WideIP)(nil)
@@ -65,8 +70,13 @@ type getListResp struct {
 }
 
 func (w *WideIP) GetList() ([]string, error) {
+	return w.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (w *WideIP) GetListCtx(ctx context.Context) ([]string, error) {
 
-	bt, err := w.c.Call(context.Background(), getListReq{
+	bt, err := w.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{GetList: struct{}{}},
 	})
@@ -121,8 +131,13 @@ type getWideIpPoolResp struct {
 }
 
 func (w *WideIP) GetWideIpPool(wideIPs []string) ([][]WideIPPool, error) {
+	return w.GetWideIpPoolCtx(context.Background(), wideIPs)
+}
 
-	bt, err := w.c.Call(context.Background(), getWideIpPoolReq{
+// GetWideIpPoolCtx is the context-aware variant of GetWideIpPool.
+func (w *WideIP) GetWideIpPoolCtx(ctx context.Context, wideIPs []string) ([][]WideIPPool, error) {
+
+	bt, err := w.c.Call(ctx, getWideIpPoolReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getWideIpPoolBody{GetWideipPool: getWideipPool{WideIPs: struct {
 			Item []string `xml:"item"`
@@ -180,8 +195,13 @@ type getLBMethodResp struct {
 }
 
 func (w *WideIP) GetLBMethod(wideIPs []string) ([]global_lb.LBMethod, error) {
+	return w.GetLBMethodCtx(context.Background(), wideIPs)
+}
 
-	bt, err := w.c.Call(context.Background(), getLBMethodReq{
+// GetLBMethodCtx is the context-aware variant of GetLBMethod.
+func (w *WideIP) GetLBMethodCtx(ctx context.Context, wideIPs []string) ([]global_lb.LBMethod, error) {
+
+	bt, err := w.c.Call(ctx, getLBMethodReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getLBMethodBody{GetLBMethod: getLBMethod{WideIPs: struct {
 			Item []string `xml:"item"`
@@ -236,8 +256,13 @@ type getObjectStatusResp struct {
 }
 
 func (w *WideIP) GetObjectStatus(wideIPs []string) ([]common.ObjectStatus, error) {
+	return w.GetObjectStatusCtx(context.Background(), wideIPs)
+}
+
+// GetObjectStatusCtx is the context-aware variant of GetObjectStatus.
+func (w *WideIP) GetObjectStatusCtx(ctx context.Context, wideIPs []string) ([]common.ObjectStatus, error) {
 
-	bt, err := w.c.Call(context.Background(), getObjectStatusReq{
+	bt, err := w.c.Call(ctx, getObjectStatusReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getObjectStatusBody{GetObjectStatus: getObjectStatus{WideIPs: struct {
 			Item []string `xml:"item"`
@@ -291,8 +316,13 @@ type getEnabledStateResp struct {
 }
 
 func (w *WideIP) GetEnabledState(wideIPs []string) ([]common.EnabledState, error) {
+	return w.GetEnabledStateCtx(context.Background(), wideIPs)
+}
+
+// GetEnabledStateCtx is the context-aware variant of GetEnabledState.
+func (w *WideIP) GetEnabledStateCtx(ctx context.Context, wideIPs []string) ([]common.EnabledState, error) {
 
-	bt, err := w.c.Call(context.Background(), getEnabledStateReq{
+	bt, err := w.c.Call(ctx, getEnabledStateReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getEnabledStateBody{GetEnabledState: getEnabledState{WideIPs: struct {
 			Item []string `xml:"item"`
@@ -1,19 +1,39 @@
 package wide_ip
 
 import (
-	"crypto/tls"
-	"encoding/json"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
-		soap.WithDebug(),
+
+	s := f5test.NewServer(t)
+	s.AddWideIPs(
+		f5test.WideIP{
+			Name:     "/Common/www.example.com",
+			LBMethod: global_lb.LBMethodGlobalAvailability,
+			Pools: []f5test.WideIPPool{
+				{Name: "/Common/pool1", Order: 0, Ratio: 2},
+				{Name: "/Common/pool2", Order: 1},
+			},
+		},
+		f5test.WideIP{
+			Name:    "/Common/repo.example.com",
+			Enabled: common.StateDisabled,
+			Pools:   []f5test.WideIPPool{{Name: "/Common/pool3"}},
+		},
+		f5test.WideIP{
+			Name: "/Common/www.example.com",
+			Type: global_lb.GtmQueryTypeAAAA,
+		},
 	)
+
+	return s.Client()
 }
 
 func TestWideIP_GetList(t *testing.T) {
@@ -25,11 +45,9 @@ func TestWideIP_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(len(res))
-
-	data, _ := json.Marshal(&res)
-
-	t.Logf("%s", data)
+	if want := []string{"/Common/www.example.com", "/Common/repo.example.com"}; !reflect.DeepEqual(res, want) {
+		t.Fatalf("expected %v, got %v", want, res)
+	}
 }
 
 func TestWideIP_GetWideIpPool(t *testing.T) {
@@ -41,16 +59,18 @@ func TestWideIP_GetWideIpPool(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(len(list))
-
 	res, err := p.GetWideIpPool(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(len(res))
-
-	t.Log(res)
+	want := [][]WideIPPool{
+		{{PoolName: "/Common/pool1", Order: 0, Ratio: 2}, {PoolName: "/Common/pool2", Order: 1, Ratio: 1}},
+		{{PoolName: "/Common/pool3", Order: 0, Ratio: 1}},
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Fatalf("expected %+v, got %+v", want, res)
+	}
 }
 
 func TestWideIP_GetLBMethod(t *testing.T) {
@@ -62,16 +82,18 @@ func TestWideIP_GetLBMethod(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(len(list))
-
 	res, err := p.GetLBMethod(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(len(res))
+	if want := []global_lb.LBMethod{global_lb.LBMethodGlobalAvailability, global_lb.LBMethodRoundRobin}; !reflect.DeepEqual(res, want) {
+		t.Fatalf("expected %v, got %v", want, res)
+	}
 
-	t.Log(res)
+	if _, err := p.GetLBMethod([]string{"/Common/missing.example.com"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
 
 func TestWideIP_GetObjectStatus(t *testing.T) {
@@ -87,7 +109,9 @@ func TestWideIP_GetObjectStatus(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(res)
+	if len(res) != 2 || res[0].AvailabilityStatus != common.AvailabilityStatusGreen || res[1].EnabledStatus != common.EnabledStatusDisabled {
+		t.Fatalf("unexpected statuses %+v", res)
+	}
 }
 
 func TestWideIP_GetEnabledState(t *testing.T) {
@@ -99,14 +123,12 @@ func TestWideIP_GetEnabledState(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(len(list))
-
 	res, err := p.GetEnabledState(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(len(res))
-
-	t.Log(res)
+	if want := []common.EnabledState{common.StateEnabled, common.StateDisabled}; !reflect.DeepEqual(res, want) {
+		t.Fatalf("expected %v, got %v", want, res)
+	}
 }
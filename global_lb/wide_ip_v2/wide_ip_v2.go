@@ -28,12 +28,19 @@ const tns = "urn:iControl:GlobalLB/WideIPV2"
 // please see the GlobalLB::PoolV2 documentation.
 type IWideIPV2 interface {
 	GetList() ([]global_lb.WideIPID, error)
+	GetListCtx(ctx context.Context) ([]global_lb.WideIPID, error)
 	GetListByType(types []global_lb.GTMQueryType) ([][]global_lb.WideIPID, error)
+	GetListByTypeCtx(ctx context.Context, types []global_lb.GTMQueryType) ([][]global_lb.WideIPID, error)
 	GetWideIpPool(wideIPs []global_lb.WideIPID) ([][]global_lb.PoolID, error)
+	GetWideIpPoolCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([][]global_lb.PoolID, error)
 	GetLBMethod(wideIPs []global_lb.WideIPID) ([]global_lb.LBMethod, error)
+	GetLBMethodCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]global_lb.LBMethod, error)
 	GetObjectStatus(wideIPs []global_lb.WideIPID) ([]common.ObjectStatus, error)
+	GetObjectStatusCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]common.ObjectStatus, error)
 	GetEnabledState(wideIPs []global_lb.WideIPID) ([]common.EnabledState, error)
+	GetEnabledStateCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]common.EnabledState, error)
 	GetWideIpPoolRatio([]global_lb.WideIPID, [][]global_lb.PoolID) ([][]int64, error)
+	GetWideIpPoolRatioCtx(context.Context, []global_lb.WideIPID, [][]global_lb.PoolID) ([][]int64, error)
 }
 
 var _ IWideIPV2 = (*WideIPV2)(nil)
@@ -74,8 +81,13 @@ type getListResp struct {
 }
 
 func (w *WideIPV2) GetList() ([]global_lb.WideIPID, error) {
+	return w.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (w *WideIPV2) GetListCtx(ctx context.Context) ([]global_lb.WideIPID, error) {
 
-	bt, err := w.c.Call(context.Background(), getListReq{
+	bt, err := w.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{GetList: struct{}{}},
 	})
@@ -135,8 +147,13 @@ type getListByTypeResp struct {
 }
 
 func (w *WideIPV2) GetListByType(types []global_lb.GTMQueryType) ([][]global_lb.WideIPID, error) {
+	return w.GetListByTypeCtx(context.Background(), types)
+}
+
+// GetListByTypeCtx is the context-aware variant of GetListByType.
+func (w *WideIPV2) GetListByTypeCtx(ctx context.Context, types []global_lb.GTMQueryType) ([][]global_lb.WideIPID, error) {
 
-	bt, err := w.c.Call(context.Background(), getListByTypeReq{
+	bt, err := w.c.Call(ctx, getListByTypeReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getListByTypeBody{GetListByType: getListByType{Types: struct {
 			Item []global_lb.GTMQueryType `xml:"item"`
@@ -203,8 +220,13 @@ type getWideIpPoolResp struct {
 }
 
 func (w *WideIPV2) GetWideIpPool(wideIPs []global_lb.WideIPID) ([][]global_lb.PoolID, error) {
+	return w.GetWideIpPoolCtx(context.Background(), wideIPs)
+}
+
+// GetWideIpPoolCtx is the context-aware variant of GetWideIpPool.
+func (w *WideIPV2) GetWideIpPoolCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([][]global_lb.PoolID, error) {
 
-	bt, err := w.c.Call(context.Background(), getWideIpPoolReq{
+	bt, err := w.c.Call(ctx, getWideIpPoolReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getWideIpPoolBody{GetWideIpPool: getWideIpPool{WideIps: struct {
 			Item []global_lb.WideIPID `xml:"item"`
@@ -261,8 +283,13 @@ type getLBMethodResp struct {
 }
 
 func (w *WideIPV2) GetLBMethod(wideIPs []global_lb.WideIPID) ([]global_lb.LBMethod, error) {
+	return w.GetLBMethodCtx(context.Background(), wideIPs)
+}
 
-	bt, err := w.c.Call(context.Background(), getLBMethodReq{
+// GetLBMethodCtx is the context-aware variant of GetLBMethod.
+func (w *WideIPV2) GetLBMethodCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]global_lb.LBMethod, error) {
+
+	bt, err := w.c.Call(ctx, getLBMethodReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetLBMethodBody{GetLBMethod: getLBMethod{WideIps: struct {
 			Item []global_lb.WideIPID `xml:"item"`
@@ -317,8 +344,13 @@ type getObjectStatusResp struct {
 }
 
 func (w *WideIPV2) GetObjectStatus(wideIPs []global_lb.WideIPID) ([]common.ObjectStatus, error) {
+	return w.GetObjectStatusCtx(context.Background(), wideIPs)
+}
 
-	bt, err := w.c.Call(context.Background(), getObjectStatusReq{
+// GetObjectStatusCtx is the context-aware variant of GetObjectStatus.
+func (w *WideIPV2) GetObjectStatusCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]common.ObjectStatus, error) {
+
+	bt, err := w.c.Call(ctx, getObjectStatusReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getObjectStatusBody{GetObjectStatus: getObjectStatus{struct {
 			Item []global_lb.WideIPID `xml:"item"`
@@ -372,8 +404,13 @@ type getEnabledStateResp struct {
 }
 
 func (w *WideIPV2) GetEnabledState(wideIPs []global_lb.WideIPID) ([]common.EnabledState, error) {
+	return w.GetEnabledStateCtx(context.Background(), wideIPs)
+}
 
-	bt, err := w.c.Call(context.Background(), getEnabledStateReq{
+// GetEnabledStateCtx is the context-aware variant of GetEnabledState.
+func (w *WideIPV2) GetEnabledStateCtx(ctx context.Context, wideIPs []global_lb.WideIPID) ([]common.EnabledState, error) {
+
+	bt, err := w.c.Call(ctx, getEnabledStateReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getEnabledStateBody{GetEnabledState: getEnabledState{struct {
 			Item []global_lb.WideIPID `xml:"item"`
@@ -431,6 +468,11 @@ type getWideIpPoolRatioResp struct {
 // GetWideIpPoolRatio Gets the ratio of the specified wide IP pools on the specified wide IPs.
 // Introduced : BIG-IP_v12.0.0
 func (w *WideIPV2) GetWideIpPoolRatio(wideIPs []global_lb.WideIPID, poolIDs [][]global_lb.PoolID) ([][]int64, error) {
+	return w.GetWideIpPoolRatioCtx(context.Background(), wideIPs, poolIDs)
+}
+
+// GetWideIpPoolRatioCtx is the context-aware variant of GetWideIpPoolRatio.
+func (w *WideIPV2) GetWideIpPoolRatioCtx(ctx context.Context, wideIPs []global_lb.WideIPID, poolIDs [][]global_lb.PoolID) ([][]int64, error) {
 
 	wideIPPools := make([]WideIPPool, 0)
 	for _, poolID := range poolIDs {
@@ -439,7 +481,7 @@ func (w *WideIPV2) GetWideIpPoolRatio(wideIPs []global_lb.WideIPID, poolIDs [][]
 		wideIPPools = append(wideIPPools, item)
 	}
 
-	bt, err := w.c.Call(context.Background(), getWideIpPoolRatioReq{
+	bt, err := w.c.Call(ctx, getWideIpPoolRatioReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getWideIpPoolRatioBody{GetWideIpPoolRatio: getWideIpPoolRatio{
 			// struct{ Item []global_lb.WideIPID }{Item: }
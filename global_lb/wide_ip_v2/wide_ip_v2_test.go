@@ -1,19 +1,40 @@
 package wide_ip_v2
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+	"github.com/wule61/go-f5-soap/f5test"
 	"github.com/wule61/go-f5-soap/global_lb"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.39/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
-		//soap.WithDebug(),
+
+	s := f5test.NewServer(t)
+	s.AddWideIPs(
+		f5test.WideIP{
+			Name:     "/Common/web01.example.com",
+			LBMethod: global_lb.LBMethodRatio,
+			Pools: []f5test.WideIPPool{
+				{Name: "/Common/pool_web01", Ratio: 3},
+				{Name: "/Common/pool_web02", Order: 1},
+			},
+		},
+		f5test.WideIP{
+			Name:    "/Common/repo.example.com",
+			Type:    global_lb.GtmQueryTypeAAAA,
+			Enabled: common.StateDisabled,
+			Pools:   []f5test.WideIPPool{{Name: "/Common/pool_repo", Ratio: 5}},
+		},
+		f5test.WideIP{
+			Name: "/Common/alias.example.com",
+			Type: global_lb.GtmQueryTypeCname,
+		},
 	)
+
+	return s.Client()
 }
 
 func TestWideIPV2_GetList(t *testing.T) {
@@ -24,7 +45,14 @@ func TestWideIPV2_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Log(list)
+	want := []global_lb.WideIPID{
+		{WideIPName: "/Common/web01.example.com", WideIPType: global_lb.GtmQueryTypeA},
+		{WideIPName: "/Common/repo.example.com", WideIPType: global_lb.GtmQueryTypeAAAA},
+		{WideIPName: "/Common/alias.example.com", WideIPType: global_lb.GtmQueryTypeCname},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Fatalf("expected %+v, got %+v", want, list)
+	}
 }
 
 func TestWideIPV2_GetListByType(t *testing.T) {
@@ -33,39 +61,47 @@ func TestWideIPV2_GetListByType(t *testing.T) {
 
 	list, err := p.GetListByType([]global_lb.GTMQueryType{
 		global_lb.GtmQueryTypeA,
-		global_lb.GtmQueryTypeAAAA,
+		global_lb.GtmQueryTypeMX,
 		global_lb.GtmQueryTypeCname,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(list)
-
+	want := [][]global_lb.WideIPID{
+		{{WideIPName: "/Common/web01.example.com", WideIPType: global_lb.GtmQueryTypeA}},
+		nil,
+		{{WideIPName: "/Common/alias.example.com", WideIPType: global_lb.GtmQueryTypeCname}},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Fatalf("expected %+v, got %+v", want, list)
+	}
 }
 
 func TestWideIPV2_GetWideIpPool(t *testing.T) {
 
 	p := New(newClient(t))
 
-	//list, err := p.GetList()
-	//if err != nil {
-	//	t.Fatal(err)
-	//}
-
 	pools, err := p.GetWideIpPool([]global_lb.WideIPID{
-		{
-			"/Common/web01y.fatp.nb", "GTM_QUERY_TYPE_A",
-		},
-		{
-			"/Common/repo.nbcb.com", "GTM_QUERY_TYPE_A",
-		},
+		{WideIPName: "/Common/web01.example.com", WideIPType: global_lb.GtmQueryTypeA},
+		{WideIPName: "/Common/repo.example.com", WideIPType: global_lb.GtmQueryTypeAAAA},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(pools)
+	want := [][]global_lb.PoolID{
+		{
+			{PoolName: "/Common/pool_web01", PoolType: global_lb.GtmQueryTypeA},
+			{PoolName: "/Common/pool_web02", PoolType: global_lb.GtmQueryTypeA},
+		},
+		{
+			{PoolName: "/Common/pool_repo", PoolType: global_lb.GtmQueryTypeAAAA},
+		},
+	}
+	if !reflect.DeepEqual(pools, want) {
+		t.Fatalf("expected %+v, got %+v", want, pools)
+	}
 }
 
 func TestWideIPV2_GetLBMethod(t *testing.T) {
@@ -77,12 +113,15 @@ func TestWideIPV2_GetLBMethod(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	pools, err := p.GetLBMethod(list)
+	methods, err := p.GetLBMethod(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(pools)
+	want := []global_lb.LBMethod{global_lb.LBMethodRatio, global_lb.LBMethodRoundRobin, global_lb.LBMethodRoundRobin}
+	if !reflect.DeepEqual(methods, want) {
+		t.Fatalf("expected %v, got %v", want, methods)
+	}
 }
 
 func TestWideIPV2_GetObjectStatus(t *testing.T) {
@@ -93,12 +132,14 @@ func TestWideIPV2_GetObjectStatus(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	pools, err := p.GetObjectStatus(list)
+	statuses, err := p.GetObjectStatus(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(pools)
+	if len(statuses) != 3 || statuses[1].EnabledStatus != common.EnabledStatusDisabled {
+		t.Fatalf("unexpected statuses %+v", statuses)
+	}
 }
 
 func TestWideIPV2_GetEnabledState(t *testing.T) {
@@ -109,51 +150,37 @@ func TestWideIPV2_GetEnabledState(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	pools, err := p.GetEnabledState(list)
+	states, err := p.GetEnabledState(list)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(pools)
+	want := []common.EnabledState{common.StateEnabled, common.StateDisabled, common.StateEnabled}
+	if !reflect.DeepEqual(states, want) {
+		t.Fatalf("expected %v, got %v", want, states)
+	}
 }
 
 func TestWideIPV2_GetWideIpPoolRatio(t *testing.T) {
 
 	p := New(newClient(t))
 
-	list, err := p.GetList()
-	if err != nil {
-		t.Fatal(err)
+	list := []global_lb.WideIPID{
+		{WideIPName: "/Common/web01.example.com", WideIPType: global_lb.GtmQueryTypeA},
+		{WideIPName: "/Common/repo.example.com", WideIPType: global_lb.GtmQueryTypeAAAA},
 	}
 
 	pools, err := p.GetWideIpPool(list)
 	if err != nil {
 		t.Fatal(err)
 	}
-	ratio, err := p.GetWideIpPoolRatio(list, pools)
 
-	//ratio, err := p.GetWideIpPoolRatio([]global_lb.WideIPID{
-	//	{
-	//		"/Common/web01y.fatp.nb", "GTM_QUERY_TYPE_A",
-	//	},
-	//	{
-	//		"/Common/repo.nbcb.com", "GTM_QUERY_TYPE_A",
-	//	},
-	//}, [][]global_lb.PoolID{
-	//	{
-	//		{
-	//			"/Common/pool_web01y.fatp.nb", "GTM_QUERY_TYPE_A",
-	//		},
-	//	},
-	//	{
-	//		{
-	//			"/Common/pool_repo.nbcb.com", "GTM_QUERY_TYPE_A",
-	//		},
-	//	},
-	//})
+	ratio, err := p.GetWideIpPoolRatio(list, pools)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Log(ratio)
+	if want := [][]int64{{3, 1}, {5}}; !reflect.DeepEqual(ratio, want) {
+		t.Fatalf("expected %v, got %v", want, ratio)
+	}
 }
@@ -1,8 +1,8 @@
 module github.com/wule61/go-f5-soap
 
-go 1.16
+go 1.18
 
 require (
-	github.com/FishGoddess/logit v0.3.3
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,116 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"time"
+)
+
+// Instrumentation observes the calls of a client, to open tracing spans or record metrics
+// such as latency histograms, error counters and in-flight gauges.
+// Adapters to OpenTelemetry or Prometheus implement it outside of this module:
+//
+//	func (t otelTracer) StartCall(ctx context.Context, call soap.CallEvent) (context.Context, func(soap.CallResult)) {
+//		ctx, span := t.tracer.Start(ctx, call.Operation, trace.WithAttributes(
+//			attribute.String("icontrol.namespace", call.Namespace), ...))
+//		return ctx, func(res soap.CallResult) {
+//			if res.Err != nil {
+//				span.SetStatus(codes.Error, res.Exception)
+//			}
+//			span.End()
+//		}
+//	}
+type Instrumentation interface {
+	// StartCall is called when a call starts. The returned context is used for the call,
+	// and end is called once with its outcome, after the retries.
+	StartCall(ctx context.Context, call CallEvent) (_ context.Context, end func(CallResult))
+}
+
+// WithInstrumentation observes the calls of the client with in, in order.
+func WithInstrumentation(in ...Instrumentation) Option {
+	return func(o *options) {
+		o.instrumentation = append(append([]Instrumentation(nil), o.instrumentation...), in...)
+	}
+}
+
+// CallEvent describes a call starting.
+type CallEvent struct {
+	URL       string // The iControl portal URL of the device.
+	Namespace string // The tns of the call, e.g. urn:iControl:GlobalLB/Pool.
+	Operation string // The method name, e.g. get_member_v2.
+	Items     int    // The number of items of the first array parameter, i.e. the batch size.
+}
+
+// CallResult is the outcome of a call.
+type CallResult struct {
+	Attempts   int           // The number of attempts made.
+	Duration   time.Duration // The duration of the call, retries included.
+	StatusCode int           // The HTTP status of the last response, zero when none was received.
+	FaultCode  string        // The SOAP faultcode of a fault, e.g. SOAP-ENV:Server.
+	Exception  string        // The iControl exception of a fault, e.g. Common::OperationFailed.
+	ErrorCode  int64         // The primary error code of a fault.
+	Err        error         // The error of the call.
+}
+
+func newCallResult(attempts int, d time.Duration, err error) CallResult {
+	res := CallResult{Attempts: attempts, Duration: d, StatusCode: statusCode(err), Err: err}
+	if f, ok := AsFault(err); ok {
+		res.FaultCode, res.Exception, res.ErrorCode = f.FaultCode, f.Exception, f.PrimaryErrorCode
+	}
+	return res
+}
+
+// startCall starts the instrumentation of a call and returns the function ending it.
+func (c *Client) startCall(ctx context.Context, op operation, body []byte) (context.Context, func(CallResult)) {
+
+	call := CallEvent{
+		URL:       c.url,
+		Namespace: op.Namespace,
+		Operation: op.Name,
+		Items:     countItems(body),
+	}
+
+	ends := make([]func(CallResult), 0, len(c.opts.instrumentation))
+	for _, in := range c.opts.instrumentation {
+		var end func(CallResult)
+		ctx, end = in.StartCall(ctx, call)
+		ends = append(ends, end)
+	}
+
+	return ctx, func(res CallResult) {
+		for i := len(ends) - 1; i >= 0; i-- {
+			if ends[i] != nil {
+				ends[i](res)
+			}
+		}
+	}
+}
+
+// countItems returns the number of items of the first parameter of an encoded request envelope.
+func countItems(body []byte) int {
+
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	depth, items := 0, 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return items
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			// Envelope > Body > operation > parameter > item
+			if depth == 5 && t.Name.Local == "item" {
+				items++
+			}
+		case xml.EndElement:
+			depth--
+			// The first parameter is over.
+			if depth == 3 {
+				return items
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package soap_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+// testInstrumentation records the calls of a client like a metrics registry would.
+type testInstrumentation struct {
+	mu       sync.Mutex
+	inFlight int
+	calls    []soap.CallEvent
+	results  []soap.CallResult
+}
+
+type spanKey struct{}
+
+func (in *testInstrumentation) StartCall(ctx context.Context, call soap.CallEvent) (context.Context, func(soap.CallResult)) {
+	in.mu.Lock()
+	in.inFlight++
+	in.calls = append(in.calls, call)
+	in.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, call.Operation), func(res soap.CallResult) {
+		in.mu.Lock()
+		defer in.mu.Unlock()
+
+		in.inFlight--
+		in.results = append(in.results, res)
+	}
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{Name: "/Common/pool1"}, f5test.Pool{Name: "/Common/pool2"})
+
+	var spans []interface{}
+	in := &testInstrumentation{}
+	p := pool.New(s.Client(
+		soap.WithInstrumentation(in),
+		soap.WithHooks(soap.Hooks{
+			BeforeRequest: func(ctx context.Context, info soap.CallInfo) context.Context {
+				spans = append(spans, ctx.Value(spanKey{}))
+				return ctx
+			},
+		}),
+	))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1", "/Common/pool2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetTTL([]string{"/Common/pool3"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
+	if _, err := p.GetList(); err != nil {
+		t.Fatal(err)
+	}
+
+	if in.inFlight != 0 || len(in.calls) != 3 || len(in.results) != 3 {
+		t.Fatalf("unexpected calls %+v, results %+v", in.calls, in.results)
+	}
+	want := []soap.CallEvent{
+		{URL: s.URL(), Namespace: "urn:iControl:GlobalLB/Pool", Operation: "get_ttl", Items: 2},
+		{URL: s.URL(), Namespace: "urn:iControl:GlobalLB/Pool", Operation: "get_ttl", Items: 1},
+		{URL: s.URL(), Namespace: "urn:iControl:GlobalLB/Pool", Operation: "get_list", Items: 0},
+	}
+	for i, call := range in.calls {
+		if call != want[i] {
+			t.Fatalf("expected %+v, got %+v", want[i], call)
+		}
+	}
+
+	if res := in.results[0]; res.Err != nil || res.StatusCode != 200 || res.Attempts != 1 || res.Duration <= 0 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	res := in.results[1]
+	if res.Err == nil || res.StatusCode != 500 || res.FaultCode == "" || res.Exception != "Common::OperationFailed" || res.ErrorCode == 0 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// The context returned by StartCall is used for the call.
+	for _, span := range spans {
+		if span == nil {
+			t.Fatalf("the instrumented context was not used: %v", spans)
+		}
+	}
+}
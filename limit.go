@@ -0,0 +1,200 @@
+package soap
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limits are the client-side limits of the requests sent to one device.
+// Retries count as requests.
+type Limits struct {
+	Rate        float64 // Requests per second allowed by the token bucket, unlimited when zero.
+	Burst       int     // Size of the token bucket, the rounded up Rate when zero.
+	MaxInFlight int     // Maximum number of concurrent requests, unlimited when zero.
+}
+
+// LimiterStats are the counters of a limiter for one device.
+type LimiterStats struct {
+	Requests     int64         // Requests admitted.
+	Canceled     int64         // Requests whose context ended while waiting.
+	Waiting      int           // Requests waiting now.
+	InFlight     int           // Requests sent and not yet answered.
+	QueueTime    time.Duration // Total time spent waiting by the admitted requests.
+	MaxQueueTime time.Duration // Longest time spent waiting by an admitted request.
+}
+
+// Limiter enforces Limits per BIG-IP URL. A limiter shared by several clients,
+// e.g. with WithSession or across a fleet, applies its limits to the sum of their requests to a device.
+type Limiter struct {
+	limits Limits
+
+	mu      sync.Mutex
+	devices map[string]*deviceLimiter
+}
+
+// NewLimiter returns a limiter applying l to each device.
+func NewLimiter(l Limits) *Limiter {
+	if l.Burst <= 0 {
+		l.Burst = int(math.Ceil(l.Rate))
+	}
+	return &Limiter{limits: l, devices: make(map[string]*deviceLimiter)}
+}
+
+// WithLimits limits the requests of the client to its device to l.
+func WithLimits(l Limits) Option {
+	return WithLimiter(NewLimiter(l))
+}
+
+// WithLimiter limits the requests of the client with l.
+func WithLimiter(l *Limiter) Option {
+	return func(o *options) {
+		o.limiter = l
+	}
+}
+
+// Stats returns the counters of the requests to url.
+func (l *Limiter) Stats(url string) LimiterStats {
+	d := l.device(url)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.stats
+}
+
+// LimiterStats returns the counters of the limiter of the client for its device.
+func (c *Client) LimiterStats() LimiterStats {
+	if c.opts.limiter == nil {
+		return LimiterStats{}
+	}
+	return c.opts.limiter.Stats(c.URL())
+}
+
+func (l *Limiter) device(url string) *deviceLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	d, ok := l.devices[url]
+	if !ok {
+		d = &deviceLimiter{limits: l.limits, tokens: float64(l.limits.Burst)}
+		if l.limits.MaxInFlight > 0 {
+			d.slots = make(chan struct{}, l.limits.MaxInFlight)
+		}
+		l.devices[url] = d
+	}
+	return d
+}
+
+// wait blocks until a request to url is allowed or ctx ends.
+// It returns the time spent waiting and the function to call once the request is answered.
+func (l *Limiter) wait(ctx context.Context, url string) (time.Duration, func(), error) {
+	return l.device(url).wait(ctx)
+}
+
+type deviceLimiter struct {
+	limits Limits
+	slots  chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	stats  LimiterStats
+}
+
+func (d *deviceLimiter) wait(ctx context.Context) (time.Duration, func(), error) {
+
+	start := time.Now()
+	d.mu.Lock()
+	d.stats.Waiting++
+	d.mu.Unlock()
+
+	err := d.acquire(ctx)
+	queued := time.Since(start)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stats.Waiting--
+	if err != nil {
+		d.stats.Canceled++
+		return queued, nil, err
+	}
+
+	d.stats.Requests++
+	d.stats.InFlight++
+	d.stats.QueueTime += queued
+	if queued > d.stats.MaxQueueTime {
+		d.stats.MaxQueueTime = queued
+	}
+
+	return queued, d.release, nil
+}
+
+// acquire takes an in-flight slot, then a token, so that the rate applies to the requests sent.
+func (d *deviceLimiter) acquire(ctx context.Context) error {
+
+	if d.slots != nil {
+		select {
+		case d.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := d.take(ctx); err != nil {
+		if d.slots != nil {
+			<-d.slots
+		}
+		return err
+	}
+
+	return nil
+}
+
+// take takes a token from the bucket, waiting for it to refill if needed.
+func (d *deviceLimiter) take(ctx context.Context) error {
+
+	if d.limits.Rate <= 0 {
+		return ctx.Err()
+	}
+
+	d.mu.Lock()
+	now := time.Now()
+	if !d.last.IsZero() {
+		d.tokens = math.Min(float64(d.limits.Burst), d.tokens+now.Sub(d.last).Seconds()*d.limits.Rate)
+	}
+	d.last = now
+	// The token is reserved now, the bucket going negative while the caller waits.
+	d.tokens--
+	delay := time.Duration(-d.tokens / d.limits.Rate * float64(time.Second))
+	d.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		d.mu.Lock()
+		d.tokens++
+		d.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (d *deviceLimiter) release() {
+	if d.slots != nil {
+		<-d.slots
+	}
+
+	d.mu.Lock()
+	d.stats.InFlight--
+	d.mu.Unlock()
+}
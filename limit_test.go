@@ -0,0 +1,127 @@
+package soap_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+// slowClient delays the requests and records how many were sent concurrently.
+type slowClient struct {
+	delay time.Duration
+
+	mu       sync.Mutex
+	inFlight int
+	max      int
+}
+
+func (c *slowClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.max {
+		c.max = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+	res, err := http.DefaultClient.Do(req)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return res, err
+}
+
+func TestLimiter_MaxInFlight(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{Name: "/Common/pool1"})
+
+	hc := &slowClient{delay: 20 * time.Millisecond}
+	l := soap.NewLimiter(soap.Limits{MaxInFlight: 2})
+
+	// Two clients of the same device share the limiter.
+	clients := []*soap.Client{
+		s.Client(soap.WithHTTPClient(hc), soap.WithLimiter(l)),
+		s.Client(soap.WithHTTPClient(hc), soap.WithLimiter(l)),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(c *soap.Client) {
+			defer wg.Done()
+			if _, err := pool.New(c).GetTTL([]string{"/Common/pool1"}); err != nil {
+				t.Error(err)
+			}
+		}(clients[i%2])
+	}
+	wg.Wait()
+
+	if hc.max != 2 {
+		t.Fatalf("expected 2 concurrent requests, got %d", hc.max)
+	}
+
+	stats := clients[0].LimiterStats()
+	if stats.Requests != 8 || stats.InFlight != 0 || stats.Waiting != 0 || stats.QueueTime <= 0 || stats.MaxQueueTime <= 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if stats != l.Stats(s.URL()) {
+		t.Fatalf("expected %+v, got %+v", l.Stats(s.URL()), stats)
+	}
+	if got := l.Stats("https://192.0.2.1/iControl/iControlPortal.cgi"); got != (soap.LimiterStats{}) {
+		t.Fatalf("unexpected stats of another device %+v", got)
+	}
+}
+
+func TestLimiter_Rate(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{Name: "/Common/pool1"})
+
+	var queued []time.Duration
+	c := s.Client(
+		soap.WithLimits(soap.Limits{Rate: 20, Burst: 2}),
+		soap.WithHooks(soap.Hooks{
+			BeforeRequest: func(ctx context.Context, info soap.CallInfo) context.Context {
+				queued = append(queued, info.QueueTime)
+				return ctx
+			},
+		}),
+	)
+	p := pool.New(c)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The burst is sent at once, the next two requests wait 50ms each.
+	if d := time.Since(start); d < 90*time.Millisecond {
+		t.Fatalf("expected the requests to be limited, took %v", d)
+	}
+	if queued[0] > 10*time.Millisecond || queued[3] < 30*time.Millisecond {
+		t.Fatalf("unexpected queue times %v", queued)
+	}
+
+	// Waiting honors the context of the call.
+	for i := 0; i < 4; i++ {
+		p.GetTTL([]string{"/Common/pool1"})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := p.GetTTLCtx(ctx, []string{"/Common/pool1"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if stats := c.LimiterStats(); stats.Canceled != 1 || stats.Requests != 8 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,142 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Logger is the structured logger of a client. *slog.Logger satisfies it,
+// other loggers such as zap can be adapted to it.
+// The args are alternating keys and values.
+type Logger interface {
+	DebugContext(ctx context.Context, msg string, args ...interface{})
+	ErrorContext(ctx context.Context, msg string, args ...interface{})
+}
+
+// WithLogger logs every call of the client to l: the operation, its duration, status and sizes,
+// and failures. With WithDebug, the redacted envelopes are logged too.
+func WithLogger(l Logger) Option {
+	return func(o *options) {
+		o.logger = l
+	}
+}
+
+// CallInfo describes one attempt of a SOAP call to the hooks.
+type CallInfo struct {
+	URL          string        // The iControl portal URL of the device.
+	Namespace    string        // The tns of the call, e.g. urn:iControl:GlobalLB/Pool.
+	Operation    string        // The method name, e.g. get_member_v2.
+	Attempt      int           // The attempt number, starting at 1. A request sent again after a new login keeps it.
+	RequestSize  int           // The size of the request envelope in bytes.
+	ResponseSize int           // The size of the response envelope in bytes, after the response.
+	StatusCode   int           // The HTTP status of the response, zero when none was received.
+	Duration     time.Duration // The duration of the attempt, after the response.
+	QueueTime    time.Duration // The time spent waiting for the limits of the client, see WithLimits.
+}
+
+// Hooks are callbacks run around every attempt of a call. Nil hooks are skipped.
+type Hooks struct {
+	// BeforeRequest runs before the request is sent. The returned context is used for the request.
+	BeforeRequest func(ctx context.Context, info CallInfo) context.Context
+	// AfterResponse runs after a successful response.
+	AfterResponse func(ctx context.Context, info CallInfo)
+	// OnError runs after a failed attempt, including faults returned by the device.
+	OnError func(ctx context.Context, info CallInfo, err error)
+}
+
+// WithHooks adds h to the hooks of the client. Hooks run in the order they were added.
+func WithHooks(h Hooks) Option {
+	return func(o *options) {
+		o.hooks = append(append([]Hooks(nil), o.hooks...), h)
+	}
+}
+
+// logHooks logs the calls to l.
+func logHooks(l Logger) Hooks {
+	return Hooks{
+		AfterResponse: func(ctx context.Context, info CallInfo) {
+			l.DebugContext(ctx, "icontrol call", info.args()...)
+		},
+		OnError: func(ctx context.Context, info CallInfo, err error) {
+			l.ErrorContext(ctx, "icontrol call failed", append(info.args(), "error", err)...)
+		},
+	}
+}
+
+func (info CallInfo) args() []interface{} {
+	return []interface{}{
+		"url", info.URL,
+		"namespace", info.Namespace,
+		"operation", info.Operation,
+		"attempt", info.Attempt,
+		"status", info.StatusCode,
+		"duration", info.Duration,
+		"queue_time", info.QueueTime,
+		"request_size", info.RequestSize,
+		"response_size", info.ResponseSize,
+	}
+}
+
+// statusCode returns the HTTP status of the response of an attempt.
+func statusCode(err error) int {
+	if err == nil {
+		return 200
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+	return 0
+}
+
+// stdLogger logs to the standard error, used by WithDebug when no logger is set.
+type stdLogger struct {
+	l *log.Logger
+}
+
+func newStdLogger() *stdLogger {
+	return &stdLogger{l: log.New(os.Stderr, "", log.LstdFlags)}
+}
+
+func (s *stdLogger) DebugContext(ctx context.Context, msg string, args ...interface{}) {
+	s.log("DEBUG", msg, args)
+}
+
+func (s *stdLogger) ErrorContext(ctx context.Context, msg string, args ...interface{}) {
+	s.log("ERROR", msg, args)
+}
+
+func (s *stdLogger) log(level, msg string, args []interface{}) {
+	var b strings.Builder
+	b.WriteString(level + " " + msg)
+	for i := 0; i+1 < len(args); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+	}
+	s.l.Print(b.String())
+}
+
+// secretTypes are the monitor string properties holding secrets.
+var secretTypes = []string{"STYPE_PASSWORD", "STYPE_SECRET", "STYPE_COMMUNITY", "STYPE_CLIENT_KEY"}
+
+var secretValueRe = regexp.MustCompile(`(<(?:[\w.-]+:)?type(?:\s[^>]*)?>\s*(` + strings.Join(secretTypes, "|") + `)\s*</(?:[\w.-]+:)?type>\s*<(?:[\w.-]+:)?value(?:\s[^>]*)?>)[^<]*(</)`)
+
+// redact replaces the secrets of an envelope: the values of DefaultScrubbedFields
+// and the values of secret monitor string properties.
+func redact(body []byte) []byte {
+	return redactSecrets(scrub(body, DefaultScrubbedFields))
+}
+
+// redactSecrets replaces the values of secret monitor string properties.
+func redactSecrets(body []byte) []byte {
+	if !bytes.Contains(body, []byte("STYPE_")) {
+		return body
+	}
+	return secretValueRe.ReplaceAll(body, []byte("${1}"+scrubbedValue+"${3}"))
+}
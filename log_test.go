@@ -0,0 +1,131 @@
+package soap_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/global_lb/monitor"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+// testLogger records the entries logged by a client.
+type testLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *testLogger) DebugContext(ctx context.Context, msg string, args ...interface{}) {
+	l.log("DEBUG", msg, args)
+}
+
+func (l *testLogger) ErrorContext(ctx context.Context, msg string, args ...interface{}) {
+	l.log("ERROR", msg, args)
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, fmt.Sprintln(append([]interface{}{level, msg}, args...)...))
+}
+
+func TestClient_Hooks(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddPools(f5test.Pool{Name: "/Common/pool1"})
+
+	type ctxKey struct{}
+	var events []string
+	c := s.Client(
+		soap.WithHooks(soap.Hooks{
+			BeforeRequest: func(ctx context.Context, info soap.CallInfo) context.Context {
+				events = append(events, "before "+info.Operation)
+				return context.WithValue(ctx, ctxKey{}, info.Operation)
+			},
+			AfterResponse: func(ctx context.Context, info soap.CallInfo) {
+				if info.StatusCode != 200 || info.RequestSize == 0 || info.ResponseSize == 0 || info.Attempt != 1 {
+					t.Errorf("unexpected call info %+v", info)
+				}
+				events = append(events, fmt.Sprintf("after %s %v", info.Operation, ctx.Value(ctxKey{})))
+			},
+			OnError: func(ctx context.Context, info soap.CallInfo, err error) {
+				events = append(events, fmt.Sprintf("error %s %d %v", info.Operation, info.StatusCode, soap.IsNotFound(err)))
+			},
+		}),
+		soap.WithHooks(soap.Hooks{
+			BeforeRequest: func(ctx context.Context, info soap.CallInfo) context.Context {
+				events = append(events, "second "+info.Namespace)
+				return ctx
+			},
+		}),
+	)
+
+	p := pool.New(c)
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetTTL([]string{"/Common/pool2"}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := []string{
+		"before get_ttl",
+		"second urn:iControl:GlobalLB/Pool",
+		"after get_ttl get_ttl",
+		"before get_ttl",
+		"second urn:iControl:GlobalLB/Pool",
+		"error get_ttl 500 true",
+	}
+	if strings.Join(events, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected events\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(events, "\n"))
+	}
+}
+
+func TestClient_Logger(t *testing.T) {
+
+	s := f5test.NewServer(t)
+	s.AddMonitors(f5test.Monitor{
+		Name:    "/Common/https_app",
+		Type:    string(monitor.TTypeHTTPS),
+		Strings: map[string]string{string(monitor.STYPE_USERNAME): "probe", string(monitor.STYPE_PASSWORD): "hunter2"},
+	})
+
+	l := &testLogger{}
+	m := monitor.New(s.Client(soap.WithLogger(l), soap.WithDebug()))
+
+	arr, err := m.GetTemplateStringProperty(
+		[]string{"/Common/https_app", "/Common/https_app"},
+		[]monitor.StrPropertyType{monitor.STYPE_USERNAME, monitor.STYPE_PASSWORD},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arr[1].Value != "hunter2" {
+		t.Fatalf("the value returned to the caller was redacted: %+v", arr)
+	}
+	if _, err := m.GetTemplateType([]string{"/Common/missing"}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	logs := strings.Join(l.entries, "")
+	if strings.Contains(logs, "hunter2") {
+		t.Fatalf("a secret was logged:\n%s", logs)
+	}
+	for _, s := range []string{
+		"DEBUG icontrol request namespace urn:iControl:GlobalLB/Monitor operation get_template_string_property",
+		"<value>probe</value>",
+		"<value>REDACTED</value>",
+		"DEBUG icontrol call url " + s.URL(),
+		"ERROR icontrol call failed",
+		"operation get_template_type attempt 1 status 500",
+	} {
+		if !strings.Contains(logs, s) {
+			t.Errorf("%q was not logged:\n%s", s, logs)
+		}
+	}
+}
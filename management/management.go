@@ -3,35 +3,40 @@
 //The Management module contains all the interfaces necessary to manage the system.
 package management
 
+import "github.com/wule61/go-f5-soap/common"
+
 // ViewInfo
 // Introduced : BIG-IP_v9.0.3
 // a struct that describes a view
 type ViewInfo struct {
-	ViewName  string   //	The name of the view
-	ViewOrder int64    //	The order of the view within the named.conf file 0 = first in zone 0xffffffff on a change means to move the view to last any other number will move the view to that position, and bump up any view(s) 1 if necessary
-	OptionSeq []string // a sequence of options for the view
-	ZoneNames []string // a sequence of zones in this view
+	ViewName  string   `json:"view_name" yaml:"view_name"`                       //	The name of the view
+	ViewOrder int64    `json:"view_order" yaml:"view_order"`                     //	The order of the view within the named.conf file 0 = first in zone 0xffffffff on a change means to move the view to last any other number will move the view to that position, and bump up any view(s) 1 if necessary
+	OptionSeq []string `json:"option_seq,omitempty" yaml:"option_seq,omitempty"` // a sequence of options for the view
+	ZoneNames []string `json:"zone_names,omitempty" yaml:"zone_names,omitempty"` // a sequence of zones in this view
 }
 
 // ViewZone
 //Introduced : BIG-IP_v9.0.3
 //A struct that describes a view/zone
 type ViewZone struct {
-	ViewName string `xml:"view_name"` // The view name.
-	ZoneName string `xml:"zone_name"` // The zone name.
+	ViewName string `xml:"view_name" json:"view_name" yaml:"view_name"` // The view name.
+	ZoneName string `xml:"zone_name" json:"zone_name" yaml:"zone_name"` // The zone name.
 }
 
 // ZoneInfo
 // Introduced : BIG-IP_v9.0.3
 // a struct that describes a zone
 type ZoneInfo struct {
-	ViewName  string   // The name of the view
-	ZoneName  string   // The name of the zone
-	ZoneType  ZoneType // one of the types of ZoneType enum
-	ZoneFile  string   // The name of the file for the zone data
-	OptionSeq []string // A sequence of options for the zone
+	ViewName  string   `json:"view_name" yaml:"view_name"`                       // The name of the view
+	ZoneName  string   `json:"zone_name" yaml:"zone_name"`                       // The name of the zone
+	ZoneType  ZoneType `json:"zone_type" yaml:"zone_type"`                       // one of the types of ZoneType enum
+	ZoneFile  string   `json:"zone_file" yaml:"zone_file"`                       // The name of the file for the zone data
+	OptionSeq []string `json:"option_seq,omitempty" yaml:"option_seq,omitempty"` // A sequence of options for the zone
 }
 
+// ZoneType
+// Introduced : BIG-IP_v9.0.3
+// An enumeration of zone types.
 type ZoneType string
 
 const (
@@ -54,138 +59,187 @@ const (
 	HINT ZoneType = "HINT"
 )
 
+// zoneTypes are the values of ZoneType.
+var zoneTypes = common.NewEnum("ZoneType", "", "BIG-IP_v9.0.3", UNSET, MASTER, SLAVE, STUB, FORWARD, HINT)
+
+// ParseZoneType returns the ZoneType whose short or iControl form is s, e.g. slave or SLAVE.
+func ParseZoneType(s string) (ZoneType, error) {
+	return zoneTypes.Parse(s)
+}
+
+// ZoneTypeValues returns the values of ZoneType.
+func ZoneTypeValues() []ZoneType {
+	return zoneTypes.Values()
+}
+
+// IsValid reports whether z is one of the ZoneType values.
+func (z ZoneType) IsValid() bool {
+	return zoneTypes.IsValid(z)
+}
+
+// String returns the iControl value of z.
+func (z ZoneType) String() string {
+	return string(z)
+}
+
+// Short returns the short form of z, e.g. slave.
+func (z ZoneType) Short() string {
+	return zoneTypes.Short(z)
+}
+
+// Introduced returns the BIG-IP version which introduced ZoneType.
+func (z ZoneType) Introduced() string {
+	return zoneTypes.Introduced()
+}
+
+func (z ZoneType) MarshalJSON() ([]byte, error) {
+	return zoneTypes.EncodeJSON(z)
+}
+
+func (z *ZoneType) UnmarshalJSON(b []byte) error {
+	return zoneTypes.DecodeJSON(b, z)
+}
+
+func (z ZoneType) MarshalYAML() (interface{}, error) {
+	return zoneTypes.EncodeYAML(z)
+}
+
+func (z *ZoneType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return zoneTypes.DecodeYAML(unmarshal, z)
+}
+
 // RRList
 // Introduced : BIG-IP_v9.0.3
 // struct that contains sequences for all possible RRtypes in a zone
 type RRList struct {
-	AList     []ARecord     // contains all A records
-	NSList    []NSRecord    // contains all NS records
-	CNAMEList []CNAMERecord // contains all CNAME records
-	SOAList   []SOARecord   // contains all SOA records
-	PTRList   []PTRRecord   // contains all PTR records
-	HInfoList []HINFORecord // contains all HINFO records
-	MXList    []MXRecord    // contains all MX records
-	TXTList   []TXTRecord   // contains all TXT records
-	SRVList   []SRVRecord   // contains all SRV records
-	KeyList   []KEYRecord   // contains all KEY records
-	SIGList   []SIGRecord   // contains all SIG records
-	NXTList   []NXTRecord   // contains all NXT records
-	AAAAList  []AAAARecord  // contains all AAAA records
-	A6List    []A6Record    // contains all A6 records
-	DNAMEList []DNAMERecord // contains all DNAME records
+	AList     []ARecord     `json:"a_list,omitempty" yaml:"a_list,omitempty"`         // contains all A records
+	NSList    []NSRecord    `json:"ns_list,omitempty" yaml:"ns_list,omitempty"`       // contains all NS records
+	CNAMEList []CNAMERecord `json:"cname_list,omitempty" yaml:"cname_list,omitempty"` // contains all CNAME records
+	SOAList   []SOARecord   `json:"soa_list,omitempty" yaml:"soa_list,omitempty"`     // contains all SOA records
+	PTRList   []PTRRecord   `json:"ptr_list,omitempty" yaml:"ptr_list,omitempty"`     // contains all PTR records
+	HInfoList []HINFORecord `json:"hinfo_list,omitempty" yaml:"hinfo_list,omitempty"` // contains all HINFO records
+	MXList    []MXRecord    `json:"mx_list,omitempty" yaml:"mx_list,omitempty"`       // contains all MX records
+	TXTList   []TXTRecord   `json:"txt_list,omitempty" yaml:"txt_list,omitempty"`     // contains all TXT records
+	SRVList   []SRVRecord   `json:"srv_list,omitempty" yaml:"srv_list,omitempty"`     // contains all SRV records
+	KeyList   []KEYRecord   `json:"key_list,omitempty" yaml:"key_list,omitempty"`     // contains all KEY records
+	SIGList   []SIGRecord   `json:"sig_list,omitempty" yaml:"sig_list,omitempty"`     // contains all SIG records
+	NXTList   []NXTRecord   `json:"nxt_list,omitempty" yaml:"nxt_list,omitempty"`     // contains all NXT records
+	AAAAList  []AAAARecord  `json:"aaaa_list,omitempty" yaml:"aaaa_list,omitempty"`   // contains all AAAA records
+	A6List    []A6Record    `json:"a6_list,omitempty" yaml:"a6_list,omitempty"`       // contains all A6 records
+	DNAMEList []DNAMERecord `json:"dname_list,omitempty" yaml:"dname_list,omitempty"` // contains all DNAME records
 }
 
 type ARecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	IPAddress  string `xml:"ip_address"`  // The ip address of the record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	IPAddress  string `xml:"ip_address" json:"ip_address" yaml:"ip_address"`    // The ip address of the record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type NSRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	HostName   string `xml:"host_name"`   // The hostname of the Name Server
-	TTL        int64  `xml:"ttl"`         // The TTL of the record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	HostName   string `xml:"host_name" json:"host_name" yaml:"host_name"`       // The hostname of the Name Server
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL of the record
 }
 
 type CNAMERecord struct {
-	DomainName string `xml:"domain_name"` //The domain name of the record
-	Cname      string `xml:"cname"`       //The cname of the record
-	TTL        int64  `xml:"ttl"`         //The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` //The domain name of the record
+	Cname      string `xml:"cname" json:"cname" yaml:"cname"`                   //The cname of the record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         //The TTL for this record
 }
 
 type SOARecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the zone
-	Primary    string `xml:"primary"`     // The primary server of the zone
-	Email      string `xml:"email"`       // The email address of the person responsible for the zone
-	Serial     int64  `xml:"serial"`      // The serial number to start with for this zone
-	Refresh    int64  `xml:"refresh"`     // The refresh interval(secs) for the zone
-	Retry      int64  `xml:"retry"`       // The interval(secs) between retries for the zone
-	Expire     int64  `xml:"expire"`      // The upper limit(secs) before a zone expires
-	NegTTL     int64  `xml:"neg_ttl"`     // The Negative TTL for any RR from this zone
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the zone
+	Primary    string `xml:"primary" json:"primary" yaml:"primary"`             // The primary server of the zone
+	Email      string `xml:"email" json:"email" yaml:"email"`                   // The email address of the person responsible for the zone
+	Serial     int64  `xml:"serial" json:"serial" yaml:"serial"`                // The serial number to start with for this zone
+	Refresh    int64  `xml:"refresh" json:"refresh" yaml:"refresh"`             // The refresh interval(secs) for the zone
+	Retry      int64  `xml:"retry" json:"retry" yaml:"retry"`                   // The interval(secs) between retries for the zone
+	Expire     int64  `xml:"expire" json:"expire" yaml:"expire"`                // The upper limit(secs) before a zone expires
+	NegTTL     int64  `xml:"neg_ttl" json:"neg_ttl" yaml:"neg_ttl"`             // The Negative TTL for any RR from this zone
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type PTRRecord struct {
-	IPAddress string `xml:"ip_address"` // The ip address of the record
-	Dname     string `xml:"dname"`      // The DNAME for this record
-	TTL       int64  `xml:"ttl"`        // The TTL for this record
+	IPAddress string `xml:"ip_address" json:"ip_address" yaml:"ip_address"` // The ip address of the record
+	Dname     string `xml:"dname" json:"dname" yaml:"dname"`                // The DNAME for this record
+	TTL       int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                      // The TTL for this record
 }
 
 type HINFORecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	Hardware   string `xml:"hardware"`    // The hardware info for this record
-	OS         string `xml:"os"`          // The OS info for the record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	Hardware   string `xml:"hardware" json:"hardware" yaml:"hardware"`          // The hardware info for this record
+	OS         string `xml:"os" json:"os" yaml:"os"`                            // The OS info for the record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type MXRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	Preference int64  `xml:"preference"`  // The preference to use for this record
-	Mail       string `xml:"mail"`        // The mail-exchanger for this record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	Preference int64  `xml:"preference" json:"preference" yaml:"preference"`    // The preference to use for this record
+	Mail       string `xml:"mail" json:"mail" yaml:"mail"`                      // The mail-exchanger for this record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type TXTRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	Text       string `xml:"text"`        // The text entry for the record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	Text       string `xml:"text" json:"text" yaml:"text"`                      // The text entry for the record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type SRVRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	Priority   int64  `xml:"priority"`    // The priority to use for this record
-	Weight     int64  `xml:"weight"`      // The weight to use for this record
-	Port       int64  `xml:"port"`        // The port for this service
-	Target     string `xml:"target"`      // The target to use for this record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	Priority   int64  `xml:"priority" json:"priority" yaml:"priority"`          // The priority to use for this record
+	Weight     int64  `xml:"weight" json:"weight" yaml:"weight"`                // The weight to use for this record
+	Port       int64  `xml:"port" json:"port" yaml:"port"`                      // The port for this service
+	Target     string `xml:"target" json:"target" yaml:"target"`                // The target to use for this record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type KEYRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	Flags      int    `xml:"flags"`       // 16bit flag for this key
-	Protocol   int    `xml:"protocol"`    // 8bit protocol indicator
-	Algorithm  int    `xml:"algorithm"`   // 8bit algorithm
-	PublicKey  string `xml:"public_key"`  // a string containing the public key
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	Flags      int    `xml:"flags" json:"flags" yaml:"flags"`                   // 16bit flag for this key
+	Protocol   int    `xml:"protocol" json:"protocol" yaml:"protocol"`          // 8bit protocol indicator
+	Algorithm  int    `xml:"algorithm" json:"algorithm" yaml:"algorithm"`       // 8bit algorithm
+	PublicKey  string `xml:"public_key" json:"public_key" yaml:"public_key"`    // a string containing the public key
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type SIGRecord struct {
-	DomainName    string `xml:"domain_name"`    // The domain name of the record
-	TypeCovered   int    `xml:"type_covered"`   // type of RR covered by this sig( NXT etc)
-	Algorithm     int    `xml:"algorithm"`      // algorithm number used
-	Labels        int    `xml:"labels"`         // how many labels in the original sig RR owner name
-	OrigTTL       int64  `xml:"orig_ttl"`       // original ttl
-	SigExpiration string `xml:"sig_expiration"` // expiration     date for sig.(secs since Jan 1….)
-	SigInception  string `xml:"sig_inception"`  // start date for sig.(secs since Jan 1….)
-	KeyTag        int    `xml:"key_tag"`        // used to select between multiple keys
-	SignerName    string `xml:"signer_name"`    // domain name of the signer that generates the sig
-	Signature     string `xml:"signature"`      // actual signature portion
-	TTL           int64  `xml:"ttl"`            // The TTL for this record
+	DomainName    string `xml:"domain_name" json:"domain_name" yaml:"domain_name"`          // The domain name of the record
+	TypeCovered   int    `xml:"type_covered" json:"type_covered" yaml:"type_covered"`       // type of RR covered by this sig( NXT etc)
+	Algorithm     int    `xml:"algorithm" json:"algorithm" yaml:"algorithm"`                // algorithm number used
+	Labels        int    `xml:"labels" json:"labels" yaml:"labels"`                         // how many labels in the original sig RR owner name
+	OrigTTL       int64  `xml:"orig_ttl" json:"orig_ttl" yaml:"orig_ttl"`                   // original ttl
+	SigExpiration string `xml:"sig_expiration" json:"sig_expiration" yaml:"sig_expiration"` // expiration     date for sig.(secs since Jan 1….)
+	SigInception  string `xml:"sig_inception" json:"sig_inception" yaml:"sig_inception"`    // start date for sig.(secs since Jan 1….)
+	KeyTag        int    `xml:"key_tag" json:"key_tag" yaml:"key_tag"`                      // used to select between multiple keys
+	SignerName    string `xml:"signer_name" json:"signer_name" yaml:"signer_name"`          // domain name of the signer that generates the sig
+	Signature     string `xml:"signature" json:"signature" yaml:"signature"`                // actual signature portion
+	TTL           int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                                  // The TTL for this record
 }
 
 type NXTRecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	NxtDomain  string `xml:"nxt_domain"`  // The next domain
-	Types      string `xml:"types"`       // a string containing all resource record types
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	NxtDomain  string `xml:"nxt_domain" json:"nxt_domain" yaml:"nxt_domain"`    // The next domain
+	Types      string `xml:"types" json:"types" yaml:"types"`                   // a string containing all resource record types
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type AAAARecord struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	IPAddress  string `xml:"ip_address"`  // The ip address of the record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	IPAddress  string `xml:"ip_address" json:"ip_address" yaml:"ip_address"`    // The ip address of the record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type A6Record struct {
-	DomainName string `xml:"domain_name"` // The domain name of the record
-	PrefixBits int    `xml:"prefix_bits"` // Number of bits contained in prefix
-	IPAddress  string `xml:"ip_address"`  // The ip address of the record
-	PrefixName string `xml:"prefix_name"` // Name to lookup to get prefix of address
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // The domain name of the record
+	PrefixBits int    `xml:"prefix_bits" json:"prefix_bits" yaml:"prefix_bits"` // Number of bits contained in prefix
+	IPAddress  string `xml:"ip_address" json:"ip_address" yaml:"ip_address"`    // The ip address of the record
+	PrefixName string `xml:"prefix_name" json:"prefix_name" yaml:"prefix_name"` // Name to lookup to get prefix of address
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
 
 type DNAMERecord struct {
-	Label      string `xml:"label"`       // The label of the record
-	DomainName string `xml:"domain_name"` // domain name for this dname record
-	TTL        int64  `xml:"ttl"`         // The TTL for this record
+	Label      string `xml:"label" json:"label" yaml:"label"`                   // The label of the record
+	DomainName string `xml:"domain_name" json:"domain_name" yaml:"domain_name"` // domain name for this dname record
+	TTL        int64  `xml:"ttl" json:"ttl" yaml:"ttl"`                         // The TTL for this record
 }
@@ -16,7 +16,9 @@ const tns = "urn:iControl:Management/ResourceRecord"
 // adding/deleting/updating This interface does not support transactions.
 type IResourceRecord interface {
 	GetRRS(viewZones []management.ViewZone) ([][]string, error)
+	GetRRSCtx(ctx context.Context, viewZones []management.ViewZone) ([][]string, error)
 	GetRRSDetailed(viewZones []management.ViewZone) ([]management.RRList, error)
+	GetRRSDetailedCtx(ctx context.Context, viewZones []management.ViewZone) ([]management.RRList, error)
 }
 
 var _ IResourceRecord = (*ResourceRecord)(nil)
@@ -58,8 +60,13 @@ type getRRSResp struct {
 }
 
 func (r *ResourceRecord) GetRRS(viewZones []management.ViewZone) ([][]string, error) {
+	return r.GetRRSCtx(context.Background(), viewZones)
+}
+
+// GetRRSCtx is the context-aware variant of GetRRS.
+func (r *ResourceRecord) GetRRSCtx(ctx context.Context, viewZones []management.ViewZone) ([][]string, error) {
 
-	bt, err := r.c.Call(context.Background(), getRRSReq{
+	bt, err := r.c.Call(ctx, getRRSReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getRRSBody{GetRRS: getRRS{ViewZones: struct {
 			Item []management.ViewZone `xml:"item"`
@@ -155,8 +162,13 @@ type getRRSDetailedResp struct {
 }
 
 func (r *ResourceRecord) GetRRSDetailed(viewZones []management.ViewZone) ([]management.RRList, error) {
+	return r.GetRRSDetailedCtx(context.Background(), viewZones)
+}
+
+// GetRRSDetailedCtx is the context-aware variant of GetRRSDetailed.
+func (r *ResourceRecord) GetRRSDetailedCtx(ctx context.Context, viewZones []management.ViewZone) ([]management.RRList, error) {
 
-	bt, err := r.c.Call(context.Background(), getRRSDetailedReq{
+	bt, err := r.c.Call(ctx, getRRSDetailedReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getRRSDetailedBody{GetRRSDetailed: getRRSDetailed{ViewZones: struct {
 			Item []management.ViewZone `xml:"item"`
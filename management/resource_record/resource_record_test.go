@@ -1,19 +1,35 @@
 package resource_record
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
 	"github.com/wule61/go-f5-soap/management"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://202.173.9.33/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
-		//soap.WithDebug(),
+
+	s := f5test.NewServer(t)
+	s.AddViews(management.ViewInfo{ViewName: "external"})
+	s.AddZones(
+		f5test.Zone{
+			ZoneInfo: management.ZoneInfo{ViewName: "external", ZoneName: "example.com.", ZoneType: management.MASTER},
+			Records: management.RRList{
+				AList:     []management.ARecord{{DomainName: "www.example.com.", IPAddress: "10.1.1.1", TTL: 300}},
+				CNAMEList: []management.CNAMERecord{{DomainName: "ftp.example.com.", Cname: "www.example.com.", TTL: 300}},
+			},
+		},
+		f5test.Zone{
+			ZoneInfo: management.ZoneInfo{ViewName: "external", ZoneName: "example.net.", ZoneType: management.MASTER},
+			Records: management.RRList{
+				NSList: []management.NSRecord{{DomainName: "example.net.", HostName: "ns1.example.net.", TTL: 3600}},
+			},
+		},
 	)
+
+	return s.Client()
 }
 
 func TestResourceRecord_GetRRS(t *testing.T) {
@@ -21,14 +37,20 @@ func TestResourceRecord_GetRRS(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetRRS([]management.ViewZone{
-		{ViewName: "external", ZoneName: "nbcb.com."},
-		{ViewName: "external", ZoneName: "nbcb.com.cn."},
+		{ViewName: "external", ZoneName: "example.com."},
+		{ViewName: "external", ZoneName: "example.net."},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := [][]string{
+		{"www.example.com.\t300\tIN\tA\t10.1.1.1", "ftp.example.com.\t300\tIN\tCNAME\twww.example.com."},
+		{"example.net.\t3600\tIN\tNS\tns1.example.net."},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %q, got %q", want, arr)
+	}
 }
 
 func TestResourceRecord_GetRRSDetailed(t *testing.T) {
@@ -36,12 +58,27 @@ func TestResourceRecord_GetRRSDetailed(t *testing.T) {
 	p := New(newClient(t))
 
 	arr, err := p.GetRRSDetailed([]management.ViewZone{
-		{ViewName: "external", ZoneName: "nbcb.com."},
-		{ViewName: "external", ZoneName: "nbcb.com.cn."},
+		{ViewName: "external", ZoneName: "example.com."},
+		{ViewName: "external", ZoneName: "example.net."},
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []management.RRList{
+		{
+			AList:     []management.ARecord{{DomainName: "www.example.com.", IPAddress: "10.1.1.1", TTL: 300}},
+			CNAMEList: []management.CNAMERecord{{DomainName: "ftp.example.com.", Cname: "www.example.com.", TTL: 300}},
+		},
+		{
+			NSList: []management.NSRecord{{DomainName: "example.net.", HostName: "ns1.example.net.", TTL: 3600}},
+		},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if _, err := p.GetRRSDetailed([]management.ViewZone{{ViewName: "external", ZoneName: "example.org."}}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
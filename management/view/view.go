@@ -15,7 +15,9 @@ const tns = "urn:iControl:Management/View"
 // The View interface contains all calls necessary to manipulate views This interface does not support transactions.
 type IView interface {
 	GetList() ([]management.ViewInfo, error)
+	GetListCtx(ctx context.Context) ([]management.ViewInfo, error)
 	GetView(viewNames []string) ([]management.ViewInfo, error)
+	GetViewCtx(ctx context.Context, viewNames []string) ([]management.ViewInfo, error)
 }
 
 var _ IView = (*View)(nil)
@@ -65,8 +67,13 @@ type getListResp struct {
 // Introduced : BIG-IP_v9.0.3
 // Get a sequence of ViewInfo structs from the server
 func (v *View) GetList() ([]management.ViewInfo, error) {
+	return v.GetListCtx(context.Background())
+}
+
+// GetListCtx is the context-aware variant of GetList.
+func (v *View) GetListCtx(ctx context.Context) ([]management.ViewInfo, error) {
 
-	bt, err := v.c.Call(context.Background(), getListReq{
+	bt, err := v.c.Call(ctx, getListReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getListBody{GetList: struct{}{}},
 	})
@@ -128,8 +135,13 @@ type getViewResp struct {
 }
 
 func (v *View) GetView(viewNames []string) ([]management.ViewInfo, error) {
+	return v.GetViewCtx(context.Background(), viewNames)
+}
+
+// GetViewCtx is the context-aware variant of GetView.
+func (v *View) GetViewCtx(ctx context.Context, viewNames []string) ([]management.ViewInfo, error) {
 
-	bt, err := v.c.Call(context.Background(), getViewReq{
+	bt, err := v.c.Call(ctx, getViewReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: GetViewBody{GetView: getView{struct {
 			Item []string `xml:"item"`
@@ -1,17 +1,24 @@
 package view
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/management"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.2.0.44/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddViews(
+		management.ViewInfo{ViewName: "external", OptionSeq: []string{"match-clients { any; };"}},
+		management.ViewInfo{ViewName: "internal", ViewOrder: 1},
 	)
+	s.AddZones(f5test.Zone{ZoneInfo: management.ZoneInfo{ViewName: "external", ZoneName: "example.com.", ZoneType: management.MASTER}})
+
+	return s.Client()
 }
 
 func TestView_GetList(t *testing.T) {
@@ -23,7 +30,9 @@ func TestView_GetList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	if len(arr) != 2 || arr[0].ViewName != "external" || arr[1].ViewName != "internal" || arr[1].ViewOrder != 1 {
+		t.Fatalf("unexpected views %+v", arr)
+	}
 }
 
 func TestView_GetView(t *testing.T) {
@@ -35,5 +44,12 @@ func TestView_GetView(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []management.ViewInfo{{
+		ViewName:  "external",
+		OptionSeq: []string{"match-clients { any; };"},
+		ZoneNames: []string{"example.com."},
+	}}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
 }
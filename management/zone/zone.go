@@ -17,8 +17,12 @@ const tns = "urn:iControl:Management/Zone"
 type IZone interface {
 	// Deprecated: Please use get_zone_v2.
 	GetZone(viewZones []management.ViewZone) ([]management.ZoneInfo, error)
+	// Deprecated: Please use get_zone_v2.
+	GetZoneCtx(ctx context.Context, viewZones []management.ViewZone) ([]management.ZoneInfo, error)
 	GetZoneV2(viewZones []management.ViewZone) ([]management.ZoneInfo, error)
+	GetZoneV2Ctx(ctx context.Context, viewZones []management.ViewZone) ([]management.ZoneInfo, error)
 	GetZoneName(viewNames []string) ([]management.ViewZone, error)
+	GetZoneNameCtx(ctx context.Context, viewNames []string) ([]management.ViewZone, error)
 }
 
 var _ IZone = (*Zone)(nil)
@@ -68,8 +72,13 @@ type getZoneNameResp struct {
 // Introduced : BIG-IP_v9.0
 // Gets the list of zone names for the specified views.
 func (z *Zone) GetZoneName(viewNames []string) ([]management.ViewZone, error) {
+	return z.GetZoneNameCtx(context.Background(), viewNames)
+}
 
-	bt, err := z.c.Call(context.Background(), getZoneNameReq{
+// GetZoneNameCtx is the context-aware variant of GetZoneName.
+func (z *Zone) GetZoneNameCtx(ctx context.Context, viewNames []string) ([]management.ViewZone, error) {
+
+	bt, err := z.c.Call(ctx, getZoneNameReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getZoneNameBody{GetZoneName: getZoneName{ViewNames: struct {
 			Item []string `xml:"item"`
@@ -143,8 +152,14 @@ type getZoneResp struct {
 // This method has been deprecated due to an inconsistency in the format of the options_seq field.
 // Deprecated: Please use get_zone_v2. Gets the ZoneInfo structs for the specified zones in the specified views.
 func (z *Zone) GetZone(viewZones []management.ViewZone) ([]management.ZoneInfo, error) {
+	return z.GetZoneCtx(context.Background(), viewZones)
+}
 
-	bt, err := z.c.Call(context.Background(), getZoneReq{
+// GetZoneCtx is the context-aware variant of GetZone.
+// Deprecated: Please use get_zone_v2.
+func (z *Zone) GetZoneCtx(ctx context.Context, viewZones []management.ViewZone) ([]management.ZoneInfo, error) {
+
+	bt, err := z.c.Call(ctx, getZoneReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getZoneBody{GetZone: getZone(struct {
 			ViewZones struct {
@@ -223,8 +238,13 @@ type getZoneV2Resp struct {
 // Introduced : BIG-IP_v12.0.0
 // Gets the ZoneInfo structs for the specified zones in the specified views.
 func (z *Zone) GetZoneV2(viewZones []management.ViewZone) ([]management.ZoneInfo, error) {
+	return z.GetZoneV2Ctx(context.Background(), viewZones)
+}
+
+// GetZoneV2Ctx is the context-aware variant of GetZoneV2.
+func (z *Zone) GetZoneV2Ctx(ctx context.Context, viewZones []management.ViewZone) ([]management.ZoneInfo, error) {
 
-	bt, err := z.c.Call(context.Background(), getZoneV2Req{
+	bt, err := z.c.Call(ctx, getZoneV2Req{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body: getZoneV2Body{GetZoneV2: getZoneV2(struct {
 			ViewZones struct {
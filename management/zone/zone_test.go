@@ -1,17 +1,24 @@
 package zone
 
 import (
-	"crypto/tls"
+	"reflect"
 	"testing"
 
 	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/management"
 )
 
 func newClient(t *testing.T) *soap.Client {
-	return soap.NewClient("https://10.1.107.243/iControl/iControlPortal.cgi",
-		soap.WithBasicAuth("admin", "admin"),
-		soap.WithTLS(&tls.Config{InsecureSkipVerify: true}),
+
+	s := f5test.NewServer(t)
+	s.AddViews(management.ViewInfo{ViewName: "external"})
+	s.AddZones(
+		f5test.Zone{ZoneInfo: management.ZoneInfo{ViewName: "external", ZoneName: "example.com.", ZoneType: management.MASTER}},
+		f5test.Zone{ZoneInfo: management.ZoneInfo{ViewName: "external", ZoneName: "example.net.", ZoneType: management.SLAVE}},
 	)
+
+	return s.Client()
 }
 
 func TestZone_GetZoneName(t *testing.T) {
@@ -23,5 +30,15 @@ func TestZone_GetZoneName(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	t.Logf("%+v", arr)
+	want := []management.ViewZone{
+		{ViewName: "external", ZoneName: "example.com."},
+		{ViewName: "external", ZoneName: "example.net."},
+	}
+	if !reflect.DeepEqual(arr, want) {
+		t.Fatalf("expected %+v, got %+v", want, arr)
+	}
+
+	if _, err := p.GetZoneName([]string{"internal"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected a not found fault, got %v", err)
+	}
 }
@@ -0,0 +1,62 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+)
+
+// operation identifies the iControl method carried by an encoded request envelope.
+type operation struct {
+	Namespace string // The tns of the envelope, e.g. urn:iControl:GlobalLB/Pool.
+	Name      string // The method name, e.g. get_member_v2.
+}
+
+// idempotent reports whether the operation only reads state from the device.
+func (o operation) idempotent() bool {
+	return strings.HasPrefix(o.Name, "get_")
+}
+
+// ParseOperation returns the namespace (tns) and the method name of an encoded request envelope,
+// e.g. urn:iControl:GlobalLB/Pool and get_member_v2.
+func ParseOperation(envelope []byte) (namespace, name string) {
+	op := parseOperation(envelope)
+	return op.Namespace, op.Name
+}
+
+// parseOperation extracts the namespace and the method name from an encoded envelope.
+// Unknown parts are left empty.
+func parseOperation(body []byte) operation {
+
+	var op operation
+
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	depth := 0
+	inBody := false
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return op
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch {
+			case depth == 1:
+				for _, attr := range t.Attr {
+					if attr.Name.Space == "xmlns" && attr.Name.Local == "tns" {
+						op.Namespace = attr.Value
+					}
+				}
+			case depth == 2 && t.Name.Local == "Body":
+				inBody = true
+			case depth == 3 && inBody:
+				op.Name = t.Name.Local
+				return op
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+)
+
+func TestParseOperation(t *testing.T) {
+
+	type req struct {
+		BaseEnvEnvelope
+		Body struct {
+			GetMemberV2 struct {
+				PoolNames struct {
+					Item []string `xml:"item"`
+				} `xml:"pool_names"`
+			} `xml:"tns:get_member_v2"`
+		} `xml:"env:Body"`
+	}
+
+	buf := new(bytes.Buffer)
+	if err := xml.NewEncoder(buf).Encode(req{BaseEnvEnvelope: NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")}); err != nil {
+		t.Fatal(err)
+	}
+
+	op := parseOperation(buf.Bytes())
+	if op.Namespace != "urn:iControl:GlobalLB/Pool" || op.Name != "get_member_v2" {
+		t.Fatalf("unexpected operation %+v", op)
+	}
+	if !op.idempotent() {
+		t.Fatal("get_member_v2 must be idempotent")
+	}
+
+	if op := parseOperation([]byte("not xml")); op != (operation{}) {
+		t.Fatalf("unexpected operation %+v", op)
+	}
+}
@@ -0,0 +1,145 @@
+package soap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy describes how transient iControl failures are retried.
+// Only idempotent calls (get_* methods) are retried, unless the call context
+// was marked with ContextWithRetrySafe.
+type RetryPolicy struct {
+	MaxAttempts    int                  // Total number of attempts, including the first one.
+	InitialBackoff time.Duration        // Delay before the first retry.
+	MaxBackoff     time.Duration        // Upper bound of the delay between two attempts.
+	Multiplier     float64              // Growth factor of the delay after each attempt.
+	Jitter         float64              // Fraction of the delay randomized, between 0 and 1.
+	Retryable      func(err error) bool // Decides which errors are retried, DefaultRetryable when nil.
+}
+
+// DefaultRetryPolicy retries up to three times with an exponential backoff starting at 200ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// WithRetry enables retries of transient failures following p.
+func WithRetry(p RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = &p
+	}
+}
+
+// DefaultRetryable reports whether err is a transient failure of the device:
+// 502/503/504 responses, "busy" OperationFailed faults and connection level errors.
+func DefaultRetryable(err error) bool {
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if fault, ok := AsFault(err); ok {
+		msg := strings.ToLower(fault.ErrorString)
+		return fault.Exception == ExceptionOperationFailed &&
+			(strings.Contains(msg, "busy") || strings.Contains(msg, "try again"))
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+type retryCtxKey int
+
+const (
+	retrySafeKey retryCtxKey = iota
+	maxAttemptsKey
+)
+
+// ContextWithRetrySafe marks the calls made with the returned context as safe to retry,
+// even if they modify the configuration of the device.
+func ContextWithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey, true)
+}
+
+// ContextWithMaxAttempts overrides RetryPolicy.MaxAttempts for the calls made with the returned context.
+func ContextWithMaxAttempts(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, maxAttemptsKey, n)
+}
+
+func isRetrySafe(ctx context.Context) bool {
+	safe, _ := ctx.Value(retrySafeKey).(bool)
+	return safe
+}
+
+func (p *RetryPolicy) maxAttempts(ctx context.Context) int {
+	if n, ok := ctx.Value(maxAttemptsKey).(int); ok {
+		return n
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return DefaultRetryable(err)
+}
+
+// backoff returns the delay to wait after the given failed attempt, starting at 1.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// wait sleeps before the next attempt, or returns early with the context error.
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) error {
+
+	t := time.NewTimer(p.backoff(attempt))
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+package soap_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/global_lb/pool"
+)
+
+const busyFault = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+<SOAP-ENV:Body>
+<SOAP-ENV:Fault>
+<faultcode>SOAP-ENV:Server</faultcode>
+<faultstring>Exception caught in GlobalLB::urn:iControl:GlobalLB/Pool::get_ttl()
+Exception: Common::OperationFailed
+	primary_error_code   : 17237812 (0x01070734)
+	secondary_error_code : 0
+	error_string         : The configuration is busy, try again later.</faultstring>
+</SOAP-ENV:Fault>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+const ttlResponse = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+<SOAP-ENV:Body>
+<m:get_ttlResponse xmlns:m="urn:iControl:GlobalLB/Pool">
+<return><item>30</item></return>
+</m:get_ttlResponse>
+</SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`
+
+// newFlakyServer fails the first n requests with the given status and body.
+func newFlakyServer(t *testing.T, n int32, status int, body string) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= n {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		_, _ = w.Write([]byte(ttlResponse))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+var fastRetry = soap.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	Multiplier:     2,
+	Jitter:         0.5,
+}
+
+type setTTLReq struct {
+	soap.BaseEnvEnvelope
+	Body struct {
+		SetTTL struct {
+			PoolNames struct {
+				Item []string `xml:"item"`
+			} `xml:"pool_names"`
+		} `xml:"tns:set_ttl"`
+	} `xml:"env:Body"`
+}
+
+func newSetTTLReq() setTTLReq {
+	r := setTTLReq{BaseEnvEnvelope: soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")}
+	r.Body.SetTTL.PoolNames.Item = []string{"/Common/pool1"}
+	return r
+}
+
+func TestRetry_Getter(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 2, http.StatusServiceUnavailable, "")
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(fastRetry)))
+
+	arr, err := p.GetTTL([]string{"/Common/pool1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 1 || arr[0] != 30 {
+		t.Fatalf("unexpected ttl %v", arr)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_BusyFault(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 1, http.StatusInternalServerError, busyFault)
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(fastRetry)))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_GiveUp(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, "")
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(fastRetry)))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_NotRetryable(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 1, http.StatusInternalServerError, notFoundFault)
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(fastRetry)))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); !soap.IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if *calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *calls)
+	}
+}
+
+func TestRetry_Classifier(t *testing.T) {
+
+	policy := fastRetry
+	policy.Retryable = func(err error) bool { return soap.IsNotFound(err) }
+
+	srv, calls := newFlakyServer(t, 1, http.StatusInternalServerError, notFoundFault)
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(policy)))
+
+	if _, err := p.GetTTL([]string{"/Common/pool1"}); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_MaxAttemptsPerCall(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, "")
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(fastRetry)))
+
+	ctx := soap.ContextWithMaxAttempts(context.Background(), 5)
+	if _, err := p.GetTTLCtx(ctx, []string{"/Common/pool1"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 5 {
+		t.Fatalf("expected 5 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_MutatingCall(t *testing.T) {
+
+	srv, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, "")
+	c := soap.NewClient(srv.URL, soap.WithRetry(fastRetry))
+
+	if _, err := c.Call(context.Background(), newSetTTLReq()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 1 {
+		t.Fatalf("mutating call must not be retried, got %d attempts", *calls)
+	}
+
+	atomic.StoreInt32(calls, 0)
+	if _, err := c.Call(soap.ContextWithRetrySafe(context.Background()), newSetTTLReq()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts for a call marked safe, got %d", *calls)
+	}
+}
+
+func TestRetry_ContextCanceledDuringBackoff(t *testing.T) {
+
+	policy := fastRetry
+	policy.InitialBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+
+	srv, _ := newFlakyServer(t, 10, http.StatusServiceUnavailable, "")
+	p := pool.New(soap.NewClient(srv.URL, soap.WithRetry(policy)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.GetTTLCtx(ctx, []string{"/Common/pool1"}); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
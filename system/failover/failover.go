@@ -0,0 +1,77 @@
+package failover
+
+import (
+	"context"
+	"encoding/xml"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/system"
+)
+
+const tns = "urn:iControl:System/Failover"
+
+// IFailover
+// Introduced : BIG-IP_v9.0
+// The Failover interface enables you to manipulate a device's fail-over states.
+// For example, you can get the device's current failover state and whether it is active or standby,
+// see soap.NewFailoverClient to route calls to the active unit of a pair.
+type IFailover interface {
+	GetFailoverState() (system.FailoverState, error)
+	GetFailoverStateCtx(ctx context.Context) (system.FailoverState, error)
+}
+
+var _ IFailover = (*Failover)(nil)
+
+type Failover struct {
+	c *soap.Client
+}
+
+func New(c *soap.Client) IFailover {
+	return &Failover{c: c}
+}
+
+type getFailoverStateReq struct {
+	soap.BaseEnvEnvelope
+	Body getFailoverStateBody `xml:"env:Body"`
+}
+
+type getFailoverStateBody struct {
+	GetFailoverState struct{} `xml:"tns:get_failover_state"`
+}
+
+type getFailoverStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetFailoverStateResponse struct {
+			Return struct {
+				Text system.FailoverState `xml:",chardata"`
+			} `xml:"return"`
+		} `xml:"get_failover_stateResponse"`
+	} `xml:"Body"`
+}
+
+// GetFailoverState
+// Introduced : BIG-IP_v9.0
+// Gets the current fail-over state of the device.
+func (f *Failover) GetFailoverState() (system.FailoverState, error) {
+	return f.GetFailoverStateCtx(context.Background())
+}
+
+// GetFailoverStateCtx is the context-aware variant of GetFailoverState.
+func (f *Failover) GetFailoverStateCtx(ctx context.Context) (system.FailoverState, error) {
+
+	bt, err := f.c.Call(ctx, getFailoverStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            getFailoverStateBody{GetFailoverState: struct{}{}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp getFailoverStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Body.GetFailoverStateResponse.Return.Text, nil
+}
@@ -0,0 +1,30 @@
+package failover
+
+import (
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/f5test"
+	"github.com/wule61/go-f5-soap/system"
+)
+
+func newClient(t *testing.T) *soap.Client {
+
+	s := f5test.NewServer(t, f5test.WithFailoverState(system.FailoverStateStandby))
+
+	return s.Client()
+}
+
+func TestFailover_GetFailoverState(t *testing.T) {
+
+	f := New(newClient(t))
+
+	state, err := f.GetFailoverState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state != system.FailoverStateStandby {
+		t.Fatalf("expected %v, got %v", system.FailoverStateStandby, state)
+	}
+}
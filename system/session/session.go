@@ -0,0 +1,342 @@
+package session
+
+import (
+	"context"
+	"encoding/xml"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+)
+
+const tns = "urn:iControl:System/Session"
+
+// ISession
+// Introduced : BIG-IP_v11.0.0
+// The Session interface allows you to work with iControl session information.
+// A session is identified by the X-iControl-Session header, see soap.Client.WithSession.
+// Its active folder, recursive query state and transaction only apply to the calls made in that session.
+type ISession interface {
+	GetSessionIdentifier() (int64, error)
+	GetSessionIdentifierCtx(ctx context.Context) (int64, error)
+	GetActiveFolder() (string, error)
+	GetActiveFolderCtx(ctx context.Context) (string, error)
+	SetActiveFolder(folder string) error
+	SetActiveFolderCtx(ctx context.Context, folder string) error
+	GetRecursiveQueryState() (common.EnabledState, error)
+	GetRecursiveQueryStateCtx(ctx context.Context) (common.EnabledState, error)
+	SetRecursiveQueryState(state common.EnabledState) error
+	SetRecursiveQueryStateCtx(ctx context.Context, state common.EnabledState) error
+	StartTransaction() error
+	StartTransactionCtx(ctx context.Context) error
+	SubmitTransaction() error
+	SubmitTransactionCtx(ctx context.Context) error
+	RollbackTransaction() error
+	RollbackTransactionCtx(ctx context.Context) error
+}
+
+var _ ISession = (*Session)(nil)
+
+type Session struct {
+	c *soap.Client
+}
+
+func New(c *soap.Client) ISession {
+	return &Session{c: c}
+}
+
+// Open gets a new session identifier from the device and returns a copy of c
+// attaching it to every call, along with the identifier.
+func Open(ctx context.Context, c *soap.Client) (*soap.Client, int64, error) {
+
+	id, err := New(c).GetSessionIdentifierCtx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return c.WithSession(id), id, nil
+}
+
+type getSessionIdentifierReq struct {
+	soap.BaseEnvEnvelope
+	Body getSessionIdentifierBody `xml:"env:Body"`
+}
+
+type getSessionIdentifierBody struct {
+	GetSessionIdentifier struct{} `xml:"tns:get_session_identifier"`
+}
+
+type getSessionIdentifierResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetSessionIdentifierResponse struct {
+			Return struct {
+				Text int64 `xml:",chardata"`
+			} `xml:"return"`
+		} `xml:"get_session_identifierResponse"`
+	} `xml:"Body"`
+}
+
+// GetSessionIdentifier
+// Introduced : BIG-IP_v11.0.0
+// Gets a new session identifier. This identifier is passed in the X-iControl-Session header
+// to have the state of the session (active folder, transaction…) apply to a call.
+func (s *Session) GetSessionIdentifier() (int64, error) {
+	return s.GetSessionIdentifierCtx(context.Background())
+}
+
+// GetSessionIdentifierCtx is the context-aware variant of GetSessionIdentifier.
+func (s *Session) GetSessionIdentifierCtx(ctx context.Context) (int64, error) {
+
+	bt, err := s.c.Call(ctx, getSessionIdentifierReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            getSessionIdentifierBody{GetSessionIdentifier: struct{}{}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var resp getSessionIdentifierResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return 0, err
+	}
+
+	return resp.Body.GetSessionIdentifierResponse.Return.Text, nil
+}
+
+type getActiveFolderReq struct {
+	soap.BaseEnvEnvelope
+	Body getActiveFolderBody `xml:"env:Body"`
+}
+
+type getActiveFolderBody struct {
+	GetActiveFolder struct{} `xml:"tns:get_active_folder"`
+}
+
+type getActiveFolderResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetActiveFolderResponse struct {
+			Return struct {
+				Text string `xml:",chardata"`
+			} `xml:"return"`
+		} `xml:"get_active_folderResponse"`
+	} `xml:"Body"`
+}
+
+// GetActiveFolder
+// Introduced : BIG-IP_v11.0.0
+// Gets the active folder of the session.
+func (s *Session) GetActiveFolder() (string, error) {
+	return s.GetActiveFolderCtx(context.Background())
+}
+
+// GetActiveFolderCtx is the context-aware variant of GetActiveFolder.
+func (s *Session) GetActiveFolderCtx(ctx context.Context) (string, error) {
+
+	bt, err := s.c.Call(ctx, getActiveFolderReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            getActiveFolderBody{GetActiveFolder: struct{}{}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp getActiveFolderResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Body.GetActiveFolderResponse.Return.Text, nil
+}
+
+type setActiveFolderReq struct {
+	soap.BaseEnvEnvelope
+	Body setActiveFolderBody `xml:"env:Body"`
+}
+
+type setActiveFolderBody struct {
+	SetActiveFolder setActiveFolder `xml:"tns:set_active_folder"`
+}
+
+type setActiveFolder struct {
+	Folder string `xml:"folder"`
+}
+
+// SetActiveFolder
+// Introduced : BIG-IP_v11.0.0
+// Sets the active folder of the session. Relative object names are resolved
+// against this folder and get_list methods return its objects, /Common by default.
+func (s *Session) SetActiveFolder(folder string) error {
+	return s.SetActiveFolderCtx(context.Background(), folder)
+}
+
+// SetActiveFolderCtx is the context-aware variant of SetActiveFolder.
+func (s *Session) SetActiveFolderCtx(ctx context.Context, folder string) error {
+
+	_, err := s.c.Call(ctx, setActiveFolderReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setActiveFolderBody{SetActiveFolder: setActiveFolder{Folder: folder}},
+	})
+
+	return err
+}
+
+type getRecursiveQueryStateReq struct {
+	soap.BaseEnvEnvelope
+	Body getRecursiveQueryStateBody `xml:"env:Body"`
+}
+
+type getRecursiveQueryStateBody struct {
+	GetRecursiveQueryState struct{} `xml:"tns:get_recursive_query_state"`
+}
+
+type getRecursiveQueryStateResp struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		GetRecursiveQueryStateResponse struct {
+			Return struct {
+				Text common.EnabledState `xml:",chardata"`
+			} `xml:"return"`
+		} `xml:"get_recursive_query_stateResponse"`
+	} `xml:"Body"`
+}
+
+// GetRecursiveQueryState
+// Introduced : BIG-IP_v11.0.0
+// Gets the recursive query state of the session.
+func (s *Session) GetRecursiveQueryState() (common.EnabledState, error) {
+	return s.GetRecursiveQueryStateCtx(context.Background())
+}
+
+// GetRecursiveQueryStateCtx is the context-aware variant of GetRecursiveQueryState.
+func (s *Session) GetRecursiveQueryStateCtx(ctx context.Context) (common.EnabledState, error) {
+
+	bt, err := s.c.Call(ctx, getRecursiveQueryStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            getRecursiveQueryStateBody{GetRecursiveQueryState: struct{}{}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp getRecursiveQueryStateResp
+	if err := xml.Unmarshal(bt, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Body.GetRecursiveQueryStateResponse.Return.Text, nil
+}
+
+type setRecursiveQueryStateReq struct {
+	soap.BaseEnvEnvelope
+	Body setRecursiveQueryStateBody `xml:"env:Body"`
+}
+
+type setRecursiveQueryStateBody struct {
+	SetRecursiveQueryState setRecursiveQueryState `xml:"tns:set_recursive_query_state"`
+}
+
+type setRecursiveQueryState struct {
+	State common.EnabledState `xml:"state"`
+}
+
+// SetRecursiveQueryState
+// Introduced : BIG-IP_v11.0.0
+// Sets the recursive query state of the session. When enabled,
+// queries return the objects of the active folder and of all its sub-folders.
+func (s *Session) SetRecursiveQueryState(state common.EnabledState) error {
+	return s.SetRecursiveQueryStateCtx(context.Background(), state)
+}
+
+// SetRecursiveQueryStateCtx is the context-aware variant of SetRecursiveQueryState.
+func (s *Session) SetRecursiveQueryStateCtx(ctx context.Context, state common.EnabledState) error {
+
+	_, err := s.c.Call(ctx, setRecursiveQueryStateReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            setRecursiveQueryStateBody{SetRecursiveQueryState: setRecursiveQueryState{State: state}},
+	})
+
+	return err
+}
+
+type startTransactionReq struct {
+	soap.BaseEnvEnvelope
+	Body startTransactionBody `xml:"env:Body"`
+}
+
+type startTransactionBody struct {
+	StartTransaction struct{} `xml:"tns:start_transaction"`
+}
+
+// StartTransaction
+// Introduced : BIG-IP_v11.0.0
+// Starts a transaction. The configuration changes made in the session are queued
+// by the device until the transaction is submitted or rolled back.
+func (s *Session) StartTransaction() error {
+	return s.StartTransactionCtx(context.Background())
+}
+
+// StartTransactionCtx is the context-aware variant of StartTransaction.
+func (s *Session) StartTransactionCtx(ctx context.Context) error {
+
+	_, err := s.c.Call(ctx, startTransactionReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            startTransactionBody{StartTransaction: struct{}{}},
+	})
+
+	return err
+}
+
+type submitTransactionReq struct {
+	soap.BaseEnvEnvelope
+	Body submitTransactionBody `xml:"env:Body"`
+}
+
+type submitTransactionBody struct {
+	SubmitTransaction struct{} `xml:"tns:submit_transaction"`
+}
+
+// SubmitTransaction
+// Introduced : BIG-IP_v11.0.0
+// Submits the transaction, applying all the queued changes at once.
+func (s *Session) SubmitTransaction() error {
+	return s.SubmitTransactionCtx(context.Background())
+}
+
+// SubmitTransactionCtx is the context-aware variant of SubmitTransaction.
+func (s *Session) SubmitTransactionCtx(ctx context.Context) error {
+
+	_, err := s.c.Call(ctx, submitTransactionReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            submitTransactionBody{SubmitTransaction: struct{}{}},
+	})
+
+	return err
+}
+
+type rollbackTransactionReq struct {
+	soap.BaseEnvEnvelope
+	Body rollbackTransactionBody `xml:"env:Body"`
+}
+
+type rollbackTransactionBody struct {
+	RollbackTransaction struct{} `xml:"tns:rollback_transaction"`
+}
+
+// RollbackTransaction
+// Introduced : BIG-IP_v11.0.0
+// Rolls back the transaction, dropping all the queued changes.
+func (s *Session) RollbackTransaction() error {
+	return s.RollbackTransactionCtx(context.Background())
+}
+
+// RollbackTransactionCtx is the context-aware variant of RollbackTransaction.
+func (s *Session) RollbackTransactionCtx(ctx context.Context) error {
+
+	_, err := s.c.Call(ctx, rollbackTransactionReq{
+		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
+		Body:            rollbackTransactionBody{RollbackTransaction: struct{}{}},
+	})
+
+	return err
+}
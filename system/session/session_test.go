@@ -0,0 +1,162 @@
+package session
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/common"
+)
+
+// sessionServer emulates the System.Session interface, keeping a state per session header.
+type sessionServer struct {
+	mu     sync.Mutex
+	folder map[string]string
+	ops    []string
+}
+
+func (s *sessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bt, _ := ioutil.ReadAll(r.Body)
+
+	var req struct {
+		Body struct {
+			Op struct {
+				XMLName xml.Name
+				Folder  string `xml:"folder"`
+				State   string `xml:"state"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+	_ = xml.Unmarshal(bt, &req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess := r.Header.Get("X-iControl-Session")
+	op := req.Body.Op.XMLName.Local
+	s.ops = append(s.ops, sess+" "+op)
+
+	ret := ""
+	switch op {
+	case "get_session_identifier":
+		ret = "<return>42</return>"
+	case "set_active_folder":
+		s.folder[sess] = req.Body.Op.Folder
+	case "get_active_folder":
+		folder, ok := s.folder[sess]
+		if !ok {
+			folder = "/Common"
+		}
+		ret = "<return>" + folder + "</return>"
+	case "get_recursive_query_state":
+		ret = "<return>STATE_DISABLED</return>"
+	}
+
+	_, _ = fmt.Fprintf(w, `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body><m:%sResponse xmlns:m="urn:iControl:System/Session">%s</m:%sResponse></SOAP-ENV:Body></SOAP-ENV:Envelope>`, op, ret, op)
+}
+
+func newClient(t *testing.T) (*soap.Client, *sessionServer) {
+	s := &sessionServer{folder: map[string]string{}}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	return soap.NewClient(srv.URL), s
+}
+
+func TestSession_GetSessionIdentifier(t *testing.T) {
+
+	c, _ := newClient(t)
+
+	id, err := New(c).GetSessionIdentifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 42 {
+		t.Fatalf("unexpected session identifier %d", id)
+	}
+}
+
+func TestSession_ActiveFolder(t *testing.T) {
+
+	c, s := newClient(t)
+
+	sc, id, err := Open(context.Background(), c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 42 {
+		t.Fatalf("unexpected session identifier %d", id)
+	}
+
+	if err := New(sc).SetActiveFolder("/Tenant"); err != nil {
+		t.Fatal(err)
+	}
+
+	folder, err := New(sc).GetActiveFolder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder != "/Tenant" {
+		t.Fatalf("unexpected active folder %q", folder)
+	}
+
+	// The original client is not part of the session.
+	folder, err = New(c).GetActiveFolder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder != "/Common" {
+		t.Fatalf("unexpected active folder %q outside of the session", folder)
+	}
+
+	want := " get_session_identifier,42 set_active_folder,42 get_active_folder, get_active_folder"
+	if got := strings.Join(s.ops, ","); got != want {
+		t.Fatalf("got calls %q, want %q", got, want)
+	}
+}
+
+func TestSession_RecursiveQueryState(t *testing.T) {
+
+	c, s := newClient(t)
+	sess := New(c.WithSession(7))
+
+	if err := sess.SetRecursiveQueryState(common.StateEnabled); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := sess.GetRecursiveQueryState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != common.StateDisabled {
+		t.Fatalf("unexpected state %q", state)
+	}
+
+	if s.ops[0] != "7 set_recursive_query_state" {
+		t.Fatalf("unexpected call %q", s.ops[0])
+	}
+}
+
+func TestSession_Transaction(t *testing.T) {
+
+	c, s := newClient(t)
+	sess := New(c.WithSession(42))
+
+	for _, f := range []func() error{sess.StartTransaction, sess.SubmitTransaction, sess.RollbackTransaction} {
+		if err := f(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := "42 start_transaction,42 submit_transaction,42 rollback_transaction"
+	if got := strings.Join(s.ops, ","); got != want {
+		t.Fatalf("got calls %q, want %q", got, want)
+	}
+}
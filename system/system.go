@@ -2,3 +2,78 @@
 // Introduced : BIG-IP_v9.0
 // The System module contains interfaces that enable you to work with system-level services.
 package system
+
+import "github.com/wule61/go-f5-soap/common"
+
+// FailoverState
+// Introduced : BIG-IP_v9.0
+// An enumeration of failover states.
+type FailoverState string
+
+const (
+	// FailoverStateStandby The system is in standby state.
+	FailoverStateStandby FailoverState = "FAILOVER_STATE_STANDBY"
+	// FailoverStateActive The system is in active state.
+	FailoverStateActive FailoverState = "FAILOVER_STATE_ACTIVE"
+	// FailoverStateForcedOffline The system is in forced offline state.
+	FailoverStateForcedOffline FailoverState = "FAILOVER_STATE_FORCED_OFFLINE"
+	// FailoverStateOffline The system is in offline state.
+	FailoverStateOffline FailoverState = "FAILOVER_STATE_OFFLINE"
+	// FailoverStateUnknown The system is in unknown state.
+	FailoverStateUnknown FailoverState = "FAILOVER_STATE_UNKNOWN"
+)
+
+// failoverStates are the values of FailoverState.
+var failoverStates = common.NewEnum("FailoverState", "FAILOVER_STATE_", "BIG-IP_v9.0",
+	FailoverStateStandby,
+	FailoverStateActive,
+	FailoverStateForcedOffline,
+	FailoverStateOffline,
+	FailoverStateUnknown,
+)
+
+// ParseFailoverState returns the FailoverState whose short or iControl form is s, e.g. forced-offline or FAILOVER_STATE_FORCED_OFFLINE.
+func ParseFailoverState(s string) (FailoverState, error) {
+	return failoverStates.Parse(s)
+}
+
+// FailoverStateValues returns the values of FailoverState.
+func FailoverStateValues() []FailoverState {
+	return failoverStates.Values()
+}
+
+// IsValid reports whether f is one of the FailoverState values.
+func (f FailoverState) IsValid() bool {
+	return failoverStates.IsValid(f)
+}
+
+// String returns the iControl value of f.
+func (f FailoverState) String() string {
+	return string(f)
+}
+
+// Short returns the short form of f, e.g. forced-offline.
+func (f FailoverState) Short() string {
+	return failoverStates.Short(f)
+}
+
+// Introduced returns the BIG-IP version which introduced FailoverState.
+func (f FailoverState) Introduced() string {
+	return failoverStates.Introduced()
+}
+
+func (f FailoverState) MarshalJSON() ([]byte, error) {
+	return failoverStates.EncodeJSON(f)
+}
+
+func (f *FailoverState) UnmarshalJSON(b []byte) error {
+	return failoverStates.DecodeJSON(b, f)
+}
+
+func (f FailoverState) MarshalYAML() (interface{}, error) {
+	return failoverStates.EncodeYAML(f)
+}
+
+func (f *FailoverState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return failoverStates.DecodeYAML(unmarshal, f)
+}
@@ -14,7 +14,9 @@ const tns = "urn:iControl:System/SystemInfo"
 // The SystemInfo interface enables you to query identifying attributes of the system.
 type ISystemInfo interface {
 	GetVersion() (string, error)
+	GetVersionCtx(ctx context.Context) (string, error)
 	GetUpTime() (int64, error)
+	GetUpTimeCtx(ctx context.Context) (int64, error)
 }
 
 type SystemInfo struct {
@@ -46,8 +48,13 @@ type getVersionResp struct {
 }
 
 func (s *SystemInfo) GetVersion() (string, error) {
+	return s.GetVersionCtx(context.Background())
+}
+
+// GetVersionCtx is the context-aware variant of GetVersion.
+func (s *SystemInfo) GetVersionCtx(ctx context.Context) (string, error) {
 
-	bt, err := s.c.Call(context.Background(), getVersionReq{
+	bt, err := s.c.Call(ctx, getVersionReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getVersionBody{GetVersion: struct{}{}},
 	})
@@ -84,8 +91,13 @@ type getUpTimeResp struct {
 }
 
 func (s *SystemInfo) GetUpTime() (int64, error) {
+	return s.GetUpTimeCtx(context.Background())
+}
+
+// GetUpTimeCtx is the context-aware variant of GetUpTime.
+func (s *SystemInfo) GetUpTimeCtx(ctx context.Context) (int64, error) {
 
-	bt, err := s.c.Call(context.Background(), getUpTimeReq{
+	bt, err := s.c.Call(ctx, getUpTimeReq{
 		BaseEnvEnvelope: soap.NewBaseEnvEnvelope(tns),
 		Body:            getUpTimeBody{GetUpTime: struct{}{}},
 	})
@@ -0,0 +1,189 @@
+// Package transaction queues configuration changes spanning several iControl interfaces
+// and applies them atomically through a System.Session transaction.
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	soap "github.com/wule61/go-f5-soap"
+	"github.com/wule61/go-f5-soap/system/session"
+)
+
+// Op is a queued change. It must make its calls through c, which is bound to the transaction session,
+// e.g. pool.New(c).SetTTLCtx(ctx, names, ttls).
+type Op func(ctx context.Context, c *soap.Client) error
+
+type queuedOp struct {
+	name string
+	fn   Op
+}
+
+// Tx queues mutating calls across the pool, pool_member, wide_ip, monitor… packages
+// and submits them in a single transaction, rolling everything back on error.
+type Tx struct {
+	c      *soap.Client
+	ops    []queuedOp
+	dryRun io.Writer
+}
+
+type Option func(*Tx)
+
+// WithDryRun makes Commit print the SOAP operations of the queued changes to w
+// instead of sending them to the device.
+func WithDryRun(w io.Writer) Option {
+	return func(t *Tx) {
+		t.dryRun = w
+	}
+}
+
+func New(c *soap.Client, opts ...Option) *Tx {
+	t := &Tx{c: c}
+	for _, o := range opts {
+		o(t)
+	}
+	return t
+}
+
+// Queue adds a change to the transaction. The name is used in errors and in the dry-run output.
+func (t *Tx) Queue(name string, fn Op) {
+	t.ops = append(t.ops, queuedOp{name: name, fn: fn})
+}
+
+// Len returns the number of queued changes.
+func (t *Tx) Len() int {
+	return len(t.ops)
+}
+
+// Error reports the queued change or the step of the transaction that made it fail.
+type Error struct {
+	Op          string // The name of the failed change, empty when the session could not be opened or the transaction started or submitted.
+	Err         error  // The error of the change or of the step.
+	RollbackErr error  // The error of the rollback, if it failed too.
+
+	step string // open, start or submit when Op is empty.
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("transaction: %s: %s", e.step, e.Err)
+	if e.Op != "" {
+		msg = fmt.Sprintf("transaction: %s: %s", e.Op, e.Err)
+	}
+	if e.RollbackErr != nil {
+		msg += " (rollback failed: " + e.RollbackErr.Error() + ")"
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Commit runs the queued changes in a new session transaction and submits it.
+// If a change or the submission fails, the transaction is rolled back and an *Error is returned.
+// The queue is emptied once the transaction is submitted, and kept on error so that Commit can be retried.
+func (t *Tx) Commit(ctx context.Context) error {
+
+	if t.dryRun != nil {
+		return t.print(ctx)
+	}
+
+	sc, _, err := session.Open(ctx, t.c)
+	if err != nil {
+		return &Error{Err: err, step: "open"}
+	}
+	s := session.New(sc)
+
+	if err := s.StartTransactionCtx(ctx); err != nil {
+		return &Error{Err: err, step: "start"}
+	}
+
+	for _, op := range t.ops {
+		if err := op.fn(ctx, sc); err != nil {
+			return &Error{Op: op.name, Err: err, RollbackErr: rollback(ctx, s)}
+		}
+	}
+
+	if err := s.SubmitTransactionCtx(ctx); err != nil {
+		return &Error{Err: err, RollbackErr: rollback(ctx, s), step: "submit"}
+	}
+
+	t.ops = nil
+	return nil
+}
+
+// rollbackTimeout bounds the rollback of a failed transaction.
+const rollbackTimeout = 30 * time.Second
+
+// rollback rolls the transaction of s back, even when ctx is canceled or past its deadline,
+// which is often why the transaction failed.
+func rollback(ctx context.Context, s session.ISession) error {
+	ctx, cancel := context.WithTimeout(detached{ctx}, rollbackTimeout)
+	defer cancel()
+	return s.RollbackTransactionCtx(ctx)
+}
+
+// detached keeps the values of a context, e.g. for the client hooks, but not its cancellation.
+type detached struct {
+	context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detached) Done() <-chan struct{}       { return nil }
+func (detached) Err() error                  { return nil }
+
+// print runs the queued changes against a copy of the client that records their requests instead of sending them.
+func (t *Tx) print(ctx context.Context) error {
+
+	rec := &recorder{}
+	c := t.c.WithHTTPClient(rec)
+
+	for i, op := range t.ops {
+		rec.calls = rec.calls[:0]
+		if err := op.fn(ctx, c); err != nil {
+			return &Error{Op: op.name, Err: err}
+		}
+
+		if _, err := fmt.Fprintf(t.dryRun, "# %d. %s\n", i+1, op.name); err != nil {
+			return err
+		}
+		for _, call := range rec.calls {
+			ns, name := soap.ParseOperation(call)
+			if _, err := fmt.Fprintf(t.dryRun, "%s %s\n%s\n", ns, name, call); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// emptyResponse is answered to every recorded call, decoding to zero values.
+const emptyResponse = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body/></SOAP-ENV:Envelope>`
+
+// recorder is a soap.HTTPClient keeping the request envelopes instead of sending them.
+type recorder struct {
+	calls [][]byte
+}
+
+func (r *recorder) Do(req *http.Request) (*http.Response, error) {
+
+	bt, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.calls = append(r.calls, bytes.TrimSpace(bt))
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/xml; charset=utf-8"}},
+		Body:       ioutil.NopCloser(strings.NewReader(emptyResponse)),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,290 @@
+package transaction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	soap "github.com/wule61/go-f5-soap"
+)
+
+const faultTemplate = `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body><SOAP-ENV:Fault><faultcode>SOAP-ENV:Server</faultcode><faultstring>Exception caught in GlobalLB::urn:iControl:GlobalLB/Pool::set_ttl()
+Exception: Common::OperationFailed
+	primary_error_code   : 16908342 (0x01020036)
+	secondary_error_code : 0
+	error_string         : 01020036:3: The requested pool (%s) was not found.</faultstring></SOAP-ENV:Fault></SOAP-ENV:Body></SOAP-ENV:Envelope>`
+
+// txServer emulates the System.Session transaction methods and GlobalLB.Pool set_ttl.
+type txServer struct {
+	mu         sync.Mutex
+	calls      []string
+	failStart  bool
+	failSubmit bool
+}
+
+func (s *txServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bt, _ := ioutil.ReadAll(r.Body)
+
+	var req struct {
+		Body struct {
+			Op struct {
+				XMLName   xml.Name
+				PoolNames []string `xml:"pool_names>item"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+	_ = xml.Unmarshal(bt, &req)
+	op := req.Body.Op.XMLName.Local
+
+	s.mu.Lock()
+	s.calls = append(s.calls, r.Header.Get("X-iControl-Session")+" "+op+" "+strings.Join(req.Body.Op.PoolNames, ","))
+	s.mu.Unlock()
+
+	ret := ""
+	switch op {
+	case "get_session_identifier":
+		ret = "<return>7</return>"
+	case "start_transaction":
+		if s.failStart {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = fmt.Fprintf(w, faultTemplate, "/Common/pool1")
+			return
+		}
+	case "submit_transaction":
+		if s.failSubmit {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = fmt.Fprintf(w, faultTemplate, "/Common/pool1")
+			return
+		}
+	case "set_ttl":
+		for _, name := range req.Body.Op.PoolNames {
+			if strings.Contains(name, "missing") {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = fmt.Fprintf(w, faultTemplate, name)
+				return
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, `<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body><m:%sResponse xmlns:m="urn:iControl">%s</m:%sResponse></SOAP-ENV:Body></SOAP-ENV:Envelope>`, op, ret, op)
+}
+
+func newClient(t *testing.T) (*soap.Client, *txServer) {
+	s := &txServer{}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	return soap.NewClient(srv.URL), s
+}
+
+type setTTLReq struct {
+	soap.BaseEnvEnvelope
+	Body struct {
+		SetTTL struct {
+			PoolNames struct {
+				Item []string `xml:"item"`
+			} `xml:"pool_names"`
+			Values struct {
+				Item []int64 `xml:"item"`
+			} `xml:"values"`
+		} `xml:"tns:set_ttl"`
+	} `xml:"env:Body"`
+}
+
+func setTTL(name string, ttl int64) Op {
+	return func(ctx context.Context, c *soap.Client) error {
+		r := setTTLReq{BaseEnvEnvelope: soap.NewBaseEnvEnvelope("urn:iControl:GlobalLB/Pool")}
+		r.Body.SetTTL.PoolNames.Item = []string{name}
+		r.Body.SetTTL.Values.Item = []int64{ttl}
+		_, err := c.Call(ctx, r)
+		return err
+	}
+}
+
+func TestTx_Commit(t *testing.T) {
+
+	c, s := newClient(t)
+
+	tx := New(c)
+	tx.Queue("set pool1 ttl", setTTL("/Common/pool1", 30))
+	tx.Queue("set pool2 ttl", setTTL("/Common/pool2", 60))
+
+	if err := tx.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		" get_session_identifier ",
+		"7 start_transaction ",
+		"7 set_ttl /Common/pool1",
+		"7 set_ttl /Common/pool2",
+		"7 submit_transaction ",
+	}
+	if strings.Join(s.calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("got calls %q, want %q", s.calls, want)
+	}
+
+	// The submitted changes are not replayed by the next commit.
+	if tx.Len() != 0 {
+		t.Fatalf("expected an empty queue, got %d changes", tx.Len())
+	}
+	s.calls = nil
+	if err := tx.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		" get_session_identifier ",
+		"7 start_transaction ",
+		"7 submit_transaction ",
+	}
+	if strings.Join(s.calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("got calls %q, want %q", s.calls, want)
+	}
+}
+
+func TestTx_SubmitRollback(t *testing.T) {
+
+	c, s := newClient(t)
+	s.failSubmit = true
+
+	tx := New(c)
+	tx.Queue("set pool1 ttl", setTTL("/Common/pool1", 30))
+
+	err := tx.Commit(context.Background())
+
+	var txErr *Error
+	if !errors.As(err, &txErr) || txErr.Op != "" || txErr.RollbackErr != nil {
+		t.Fatalf("expected a submission error, got %v", err)
+	}
+
+	want := []string{
+		" get_session_identifier ",
+		"7 start_transaction ",
+		"7 set_ttl /Common/pool1",
+		"7 submit_transaction ",
+		"7 rollback_transaction ",
+	}
+	if strings.Join(s.calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("got calls %q, want %q", s.calls, want)
+	}
+
+	// The changes are kept for a retry.
+	if tx.Len() != 1 {
+		t.Fatalf("expected the queue to be kept, got %d changes", tx.Len())
+	}
+}
+
+func TestTx_Rollback(t *testing.T) {
+
+	c, s := newClient(t)
+
+	tx := New(c)
+	tx.Queue("set pool1 ttl", setTTL("/Common/pool1", 30))
+	tx.Queue("set missing ttl", setTTL("/Common/missing", 60))
+	tx.Queue("set pool2 ttl", setTTL("/Common/pool2", 60))
+
+	err := tx.Commit(context.Background())
+
+	var txErr *Error
+	if !errors.As(err, &txErr) || txErr.Op != "set missing ttl" {
+		t.Fatalf("expected a transaction error on the second change, got %v", err)
+	}
+	if !soap.IsNotFound(err) {
+		t.Fatalf("expected the fault to be preserved, got %v", err)
+	}
+
+	want := []string{
+		" get_session_identifier ",
+		"7 start_transaction ",
+		"7 set_ttl /Common/pool1",
+		"7 set_ttl /Common/missing",
+		"7 rollback_transaction ",
+	}
+	if strings.Join(s.calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("got calls %q, want %q", s.calls, want)
+	}
+}
+
+func TestTx_RollbackCanceled(t *testing.T) {
+
+	c, s := newClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx := New(c)
+	tx.Queue("set pool1 ttl", func(ctx context.Context, c *soap.Client) error {
+		cancel()
+		return ctx.Err()
+	})
+
+	err := tx.Commit(ctx)
+
+	var txErr *Error
+	if !errors.As(err, &txErr) || !errors.Is(err, context.Canceled) || txErr.RollbackErr != nil {
+		t.Fatalf("expected a canceled change rolled back, got %v", err)
+	}
+	if last := s.calls[len(s.calls)-1]; last != "7 rollback_transaction " {
+		t.Fatalf("expected a rollback, got calls %q", s.calls)
+	}
+}
+
+func TestTx_StartError(t *testing.T) {
+
+	c, s := newClient(t)
+	s.failStart = true
+
+	tx := New(c)
+	tx.Queue("set pool1 ttl", setTTL("/Common/pool1", 30))
+
+	err := tx.Commit(context.Background())
+
+	var txErr *Error
+	if !errors.As(err, &txErr) || txErr.Op != "" || !soap.IsNotFound(err) {
+		t.Fatalf("expected a transaction error, got %v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "transaction: start: ") {
+		t.Fatalf("unexpected message %q", err)
+	}
+}
+
+func TestTx_DryRun(t *testing.T) {
+
+	c, s := newClient(t)
+
+	out := new(bytes.Buffer)
+	tx := New(c, WithDryRun(out))
+	tx.Queue("set pool1 ttl", setTTL("/Common/pool1", 30))
+	tx.Queue("set pool2 ttl", setTTL("/Common/pool2", 60))
+
+	if tx.Len() != 2 {
+		t.Fatalf("unexpected queue length %d", tx.Len())
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.calls) != 0 {
+		t.Fatalf("dry-run must not call the device, got %q", s.calls)
+	}
+
+	got := out.String()
+	for _, want := range []string{
+		"# 1. set pool1 ttl\nurn:iControl:GlobalLB/Pool set_ttl\n",
+		"<pool_names><item>/Common/pool1</item></pool_names><values><item>30</item></values>",
+		"# 2. set pool2 ttl\nurn:iControl:GlobalLB/Pool set_ttl\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("dry-run output %q does not contain %q", got, want)
+		}
+	}
+}